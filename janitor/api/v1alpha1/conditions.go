@@ -0,0 +1,76 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MaxStatusConditions bounds the number of conditions kept in a node action status
+	MaxStatusConditions = 16
+
+	// MaxConditionMessageLength is the longest condition message accepted by the CRD validation
+	MaxConditionMessageLength = 32768
+)
+
+// trimConditions bounds conditions so that status writes stay valid and well under the etcd object
+// size limit. Only the most recent condition of each type is kept, messages are truncated to
+// MaxConditionMessageLength and, past MaxStatusConditions, the oldest conditions are dropped. The
+// order of the remaining conditions is preserved.
+func trimConditions(conditions []metav1.Condition) []metav1.Condition {
+	if len(conditions) == 0 {
+		return conditions
+	}
+
+	trimmed := make([]metav1.Condition, 0, len(conditions))
+	indexByType := make(map[string]int, len(conditions))
+
+	for _, condition := range conditions {
+		if len(condition.Message) > MaxConditionMessageLength {
+			// Cutting at a byte offset may split a multi-byte rune, which is dropped
+			condition.Message = strings.ToValidUTF8(condition.Message[:MaxConditionMessageLength], "")
+		}
+
+		i, exists := indexByType[condition.Type]
+		if !exists {
+			indexByType[condition.Type] = len(trimmed)
+			trimmed = append(trimmed, condition)
+
+			continue
+		}
+
+		if !condition.LastTransitionTime.Before(&trimmed[i].LastTransitionTime) {
+			trimmed[i] = condition
+		}
+	}
+
+	for len(trimmed) > MaxStatusConditions {
+		oldest := 0
+
+		for i := range trimmed {
+			if trimmed[i].LastTransitionTime.Before(&trimmed[oldest].LastTransitionTime) {
+				oldest = i
+			}
+		}
+
+		trimmed = slices.Delete(trimmed, oldest, oldest+1)
+	}
+
+	return trimmed
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRebootNodeStatus_TrimDuplicateConditions(t *testing.T) {
+	now := time.Now()
+	status := RebootNodeStatus{Conditions: []metav1.Condition{
+		{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded",
+			LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+		{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue, Reason: "Succeeded",
+			LastTransitionTime: metav1.NewTime(now)},
+		{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "Initializing",
+			LastTransitionTime: metav1.NewTime(now.Add(-2 * time.Hour))},
+		{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionUnknown, Reason: "Initializing",
+			LastTransitionTime: metav1.NewTime(now.Add(-2 * time.Hour))},
+	}}
+
+	status.Trim()
+
+	require.Len(t, status.Conditions, 2)
+	assert.Equal(t, RebootNodeConditionSignalSent, status.Conditions[0].Type)
+	assert.Equal(t, "Succeeded", status.Conditions[0].Reason)
+	assert.Equal(t, RebootNodeConditionNodeReady, status.Conditions[1].Type)
+	assert.Equal(t, "Succeeded", status.Conditions[1].Reason)
+}
+
+func TestRebootNodeStatus_TrimBoundsConditions(t *testing.T) {
+	now := time.Now()
+
+	var status RebootNodeStatus
+	for i := range MaxStatusConditions + 4 {
+		status.Conditions = append(status.Conditions, metav1.Condition{
+			Type:               fmt.Sprintf("Condition%d", i),
+			Status:             metav1.ConditionTrue,
+			Reason:             "Test",
+			LastTransitionTime: metav1.NewTime(now.Add(time.Duration(i) * time.Minute)),
+		})
+	}
+
+	status.Trim()
+
+	require.Len(t, status.Conditions, MaxStatusConditions)
+	// The four oldest conditions are dropped and the order is kept
+	assert.Equal(t, "Condition4", status.Conditions[0].Type)
+	assert.Equal(t, fmt.Sprintf("Condition%d", MaxStatusConditions+3), status.Conditions[MaxStatusConditions-1].Type)
+}
+
+func TestRebootNodeStatus_TrimConditionMessages(t *testing.T) {
+	status := RebootNodeStatus{Conditions: []metav1.Condition{
+		{Type: RebootNodeConditionNodeReady, Message: "é" + strings.Repeat("x", MaxConditionMessageLength)},
+		{Type: RebootNodeConditionSignalSent, Message: "short"},
+	}}
+
+	status.Trim()
+
+	assert.LessOrEqual(t, len(status.Conditions[0].Message), MaxConditionMessageLength)
+	assert.True(t, utf8.ValidString(status.Conditions[0].Message))
+	assert.Equal(t, "short", status.Conditions[1].Message)
+}
+
+func TestTerminateNodeStatus_Trim(t *testing.T) {
+	status := TerminateNodeStatus{Conditions: []metav1.Condition{
+		{Type: TerminateNodeConditionNodeTerminated, Reason: "Initializing"},
+		{Type: TerminateNodeConditionNodeTerminated, Reason: "Succeeded"},
+	}}
+
+	status.Trim()
+
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, "Succeeded", status.Conditions[0].Reason)
+}
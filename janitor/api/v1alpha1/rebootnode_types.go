@@ -16,6 +16,10 @@
 package v1alpha1
 
 import (
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +31,65 @@ const (
 	RebootNodeConditionNodeReady = "NodeReady"
 	// ManualModeConditionType indicates that manual mode is enabled and outside actor is required
 	ManualModeConditionType = "ManualMode"
+	// RebootNodeConditionRebootExcluded indicates that the target node is excluded from janitor reboots
+	RebootNodeConditionRebootExcluded = "RebootExcluded"
+	// RebootNodeConditionEscalatedToHardReboot indicates that a timed out soft reboot was retried as a hard reboot
+	RebootNodeConditionEscalatedToHardReboot = "EscalatedToHardReboot"
+	// RebootNodeConditionGPUReady indicates whether the node GPUs are available after the node returned to ready state
+	RebootNodeConditionGPUReady = "GPUReady"
+	// RebootNodeConditionPreRebootJob indicates the state of the pre-reboot hook Job
+	RebootNodeConditionPreRebootJob = "PreRebootJob"
+	// RebootNodeConditionPostRebootJob indicates the state of the post-reboot verification Job
+	RebootNodeConditionPostRebootJob = "PostRebootJob"
+	// RebootNodeConditionPaused indicates whether the RebootNode is paused by annotation
+	RebootNodeConditionPaused = "Paused"
+	// RebootNodeConditionBudgetExhausted indicates whether the reboot is deferred because the cluster-wide
+	// reboot budget of the current window is spent
+	RebootNodeConditionBudgetExhausted = "BudgetExhausted"
+	// RebootNodeConditionInsufficientSpareCapacity indicates whether the reboot is deferred because the
+	// other ready nodes do not have enough allocatable GPUs to absorb the node going down
+	RebootNodeConditionInsufficientSpareCapacity = "InsufficientSpareCapacity"
+	// RebootNodeConditionRebootRefusedControlPlane indicates that the reboot failed without a signal because
+	// the target node is a control-plane node and rebooting control-plane nodes is not allowed
+	RebootNodeConditionRebootRefusedControlPlane = "RebootRefusedControlPlane"
+)
+
+// RebootRefusedControlPlaneReason is the reason of the RebootRefusedControlPlane condition and the
+// failure reason of a refused reboot
+const RebootRefusedControlPlaneReason = "ControlPlaneNode"
+
+// Reasons of the pre-reboot hook Job condition
+const (
+	// PreRebootJobRunning means the hook Job was created and the reboot waits for it to finish
+	PreRebootJobRunning = "PreRebootJobRunning"
+	// PreRebootJobSucceeded means the hook Job completed and the reboot signal may be sent
+	PreRebootJobSucceeded = "PreRebootJobSucceeded"
+	// PreRebootJobFailed means the hook Job failed or timed out and the node was not rebooted
+	PreRebootJobFailed = "PreRebootJobFailed"
+)
+
+// Reasons of the post-reboot verification Job condition
+const (
+	// PostRebootJobRunning means the verification Job was created after the node returned to ready state
+	PostRebootJobRunning = "PostRebootJobRunning"
+	// PostRebootJobSucceeded means the verification Job completed and the reboot may be declared successful
+	PostRebootJobSucceeded = "PostRebootJobSucceeded"
+	// PostRebootJobFailed means the verification Job failed or timed out
+	PostRebootJobFailed = "PostRebootJobFailed"
+	// VerificationJobFailed is the NodeReady reason of a reboot failed by its verification Job
+	VerificationJobFailed = "VerificationJobFailed"
+)
+
+// RebootNotObserved is the NodeReady reason of a reboot that timed out while the node was ready but
+// showed no sign of having rebooted since the signal was sent
+const RebootNotObserved = "RebootNotObserved"
+
+// RebootNode reboot types
+const (
+	// RebootTypeSoft requests a graceful restart of the node
+	RebootTypeSoft = "Soft"
+	// RebootTypeHard requests a power cycle of the node
+	RebootTypeHard = "Hard"
 )
 
 // RebootNodeSpec defines the desired state of RebootNode
@@ -39,6 +102,34 @@ type RebootNodeSpec struct {
 	// NodeName is the name of the node to reboot
 	// +kubebuilder:validation:Required
 	NodeName string `json:"nodeName"`
+
+	// RebootType selects a graceful (Soft) or power cycle (Hard) reboot
+	// CSPs that only support one kind of reboot use it for both
+	// +kubebuilder:validation:Enum=Soft;Hard
+	// +kubebuilder:default:=Soft
+	// +optional
+	RebootType string `json:"rebootType,omitempty"`
+
+	// Priority orders RebootNodes waiting for a reboot slot when the controller limits concurrent
+	// reboots; the controller configuration selects whether higher or lower values go first
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// PreRebootJobTemplate describes a Job run to completion before the reboot signal is sent,
+	// e.g. to flush caches or notify a scheduler. The node is not rebooted if the Job fails.
+	// The Job runs in the janitor hook namespace; a template setting another namespace fails.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	PreRebootJobTemplate *batchv1.JobTemplateSpec `json:"preRebootJobTemplate,omitempty"`
+
+	// PostRebootJobTemplate describes a Job run to completion once the node returned to ready state,
+	// e.g. a GPU smoke test. The reboot only succeeds if the Job succeeds.
+	// The Job runs in the janitor hook namespace; a template setting another namespace fails.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	PostRebootJobTemplate *batchv1.JobTemplateSpec `json:"postRebootJobTemplate,omitempty"`
 }
 
 // RebootNodeStatus defines the observed state of RebootNode
@@ -46,6 +137,9 @@ type RebootNodeStatus struct {
 	// StartTime is the time when the reboot was initiated
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// SignalSentTime is the time when the reboot signal was first sent, or handed off in manual mode
+	SignalSentTime *metav1.Time `json:"signalSentTime,omitempty"`
+
 	// CompletionTime is the time when the reboot was completed
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
@@ -57,6 +151,28 @@ type RebootNodeStatus struct {
 	// Reset to 0 on successful operations
 	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
 
+	// NextAttemptTime is the time at which the controller has scheduled the next reconcile attempt
+	// Cleared once the reboot reaches a terminal state
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+
+	// PreRebootBootID is the node's boot ID observed when the reboot signal was sent
+	PreRebootBootID string `json:"preRebootBootID,omitempty"`
+
+	// PreRebootNodeNotReady records that the node was already NotReady when the reboot signal was sent
+	// In that case readiness alone cannot prove the reboot happened, so a boot ID change is required
+	PreRebootNodeNotReady bool `json:"preRebootNodeNotReady,omitempty"`
+
+	// PreRebootKubeletStartTime is the kubelet start time observed when the reboot signal was sent
+	// Only recorded when reboots are verified by the kubelet start time
+	PreRebootKubeletStartTime *metav1.Time `json:"preRebootKubeletStartTime,omitempty"`
+
+	// ReadySince is the time since which the node has been found ready in every check of the reboot
+	// Cleared whenever the node is found not ready, restarting the ready stability window
+	ReadySince *metav1.Time `json:"readySince,omitempty"`
+
+	// SLABreached records that the reboot took longer than the configured SLA to complete
+	SLABreached bool `json:"slaBreached,omitempty"`
+
 	// Conditions represent the latest available observations of an object's current state.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -67,6 +183,8 @@ type RebootNodeStatus struct {
 // +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
 // +kubebuilder:printcolumn:name="Force",type="boolean",JSONPath=".spec.force"
 // +kubebuilder:printcolumn:name="NodeReady",type="string",JSONPath=".status.conditions[?(@.type=='NodeReady')].status"
+// +kubebuilder:printcolumn:name="SignalSent",type="date",JSONPath=".status.signalSentTime",priority=1
+// +kubebuilder:printcolumn:name="Completed",type="date",JSONPath=".status.completionTime",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // RebootNode is the Schema for the rebootnodes API
@@ -114,6 +232,68 @@ func (r *RebootNode) GetCSPReqRef() string {
 	return ""
 }
 
+// IsSucceeded returns true if the reboot completed and the node returned to the ready state
+func (r *RebootNode) IsSucceeded() bool {
+	if r.Status.CompletionTime == nil {
+		return false
+	}
+
+	nodeReady := meta.FindStatusCondition(r.Status.Conditions, RebootNodeConditionNodeReady)
+
+	return nodeReady != nil && nodeReady.Status == metav1.ConditionTrue
+}
+
+// IsFailed returns true if the reboot completed without the node returning to the ready state.
+// A reboot skipped because the node is excluded from reboots is neither succeeded nor failed.
+func (r *RebootNode) IsFailed() bool {
+	if r.Status.CompletionTime == nil || r.IsSucceeded() {
+		return false
+	}
+
+	return !meta.IsStatusConditionTrue(r.Status.Conditions, RebootNodeConditionRebootExcluded)
+}
+
+// FailureReason returns the reason of the condition that failed the reboot, e.g. Timeout or
+// MaxRetriesExceeded, or an empty string if the reboot has not failed
+func (r *RebootNode) FailureReason() string {
+	if !r.IsFailed() {
+		return ""
+	}
+
+	if meta.IsStatusConditionTrue(r.Status.Conditions, RebootNodeConditionRebootRefusedControlPlane) {
+		return RebootRefusedControlPlaneReason
+	}
+
+	// The signal and escalation conditions explain a failure better than the NodeReady condition
+	// they leave behind
+	for _, conditionType := range []string{
+		RebootNodeConditionSignalSent,
+		RebootNodeConditionEscalatedToHardReboot,
+		RebootNodeConditionNodeReady,
+	} {
+		if meta.IsStatusConditionFalse(r.Status.Conditions, conditionType) {
+			return meta.FindStatusCondition(r.Status.Conditions, conditionType).Reason
+		}
+	}
+
+	return ""
+}
+
+// CurrentCondition returns the most recently transitioned condition, or nil if there are none.
+// Conditions that transitioned at the same time are resolved in favor of the last one recorded.
+func (r *RebootNode) CurrentCondition() *metav1.Condition {
+	var current *metav1.Condition
+
+	for i := range r.Status.Conditions {
+		condition := &r.Status.Conditions[i]
+		if current == nil || !condition.LastTransitionTime.Before(&current.LastTransitionTime) {
+			current = condition
+		}
+	}
+
+	return current
+}
+
 // SetInitialConditions sets the initial conditions for the RebootNode to Unknown state
 func (r *RebootNode) SetInitialConditions() {
 	now := metav1.Now()
@@ -198,6 +378,79 @@ func (r *RebootNode) SetCompletionTime() {
 	}
 }
 
+// SetSignalSentTime sets the signal sent time to now if not set, so that an escalated hard reboot
+// keeps the time of the first signal
+func (r *RebootNode) SetSignalSentTime() {
+	if r.Status.SignalSentTime == nil {
+		now := metav1.Now()
+		r.Status.SignalSentTime = &now
+	}
+}
+
+// QueuedDuration returns the time from the creation of the RebootNode until the reboot signal was
+// sent, including any wait for a reboot slot, the reboot budget or a pre-reboot Job. It returns false
+// if the signal has not been sent.
+func (r *RebootNode) QueuedDuration() (time.Duration, bool) {
+	if r.Status.SignalSentTime == nil {
+		return 0, false
+	}
+
+	return r.Status.SignalSentTime.Sub(r.CreationTimestamp.Time), true
+}
+
+// RebootingDuration returns the time from the reboot signal until the node was declared ready. It
+// returns false unless the reboot succeeded after a signal.
+func (r *RebootNode) RebootingDuration() (time.Duration, bool) {
+	if r.Status.SignalSentTime == nil || !r.IsSucceeded() {
+		return 0, false
+	}
+
+	return r.Status.CompletionTime.Sub(r.Status.SignalSentTime.Time), true
+}
+
+// SetNextAttemptTime records when the next reconcile attempt is scheduled based on the requeue delay.
+// It is cleared when no requeue is scheduled or the reboot has reached a terminal state. A recorded
+// time within half the delay of the new one is kept, so that reconciles in quick succession, e.g.
+// the one triggered by the previous status write, do not write the status again just to move it.
+func (r *RebootNode) SetNextAttemptTime(requeueAfter time.Duration) {
+	if requeueAfter <= 0 || r.Status.CompletionTime != nil {
+		r.Status.NextAttemptTime = nil
+		return
+	}
+
+	next := metav1.NewTime(time.Now().Add(requeueAfter))
+
+	if current := r.Status.NextAttemptTime; current != nil {
+		drift := next.Sub(current.Time)
+		if drift < 0 {
+			drift = -drift
+		}
+
+		if drift <= max(requeueAfter/2, time.Second) {
+			return
+		}
+	}
+
+	r.Status.NextAttemptTime = &next
+}
+
+// RecordPreRebootState captures the node's readiness and boot ID at the time the reboot signal is sent
+func (r *RebootNode) RecordPreRebootState(nodeReady bool, bootID string) {
+	r.Status.PreRebootNodeNotReady = !nodeReady
+	r.Status.PreRebootBootID = bootID
+}
+
+// IsRebootObserved reports whether the node's current boot ID proves the reboot happened.
+// It only requires a boot ID change when the node was already NotReady before the reboot,
+// since readiness cannot distinguish "still down from before" from "down due to our reboot".
+func (r *RebootNode) IsRebootObserved(currentBootID string) bool {
+	if !r.Status.PreRebootNodeNotReady || r.Status.PreRebootBootID == "" {
+		return true
+	}
+
+	return currentBootID != r.Status.PreRebootBootID
+}
+
 // Interface implementation for generic status update handling
 
 // GetRetryCount returns the retry count
@@ -220,11 +473,21 @@ func (s *RebootNodeStatus) GetCompletionTime() *metav1.Time {
 	return s.CompletionTime
 }
 
+// GetNextAttemptTime returns the time of the next scheduled reconcile attempt
+func (s *RebootNodeStatus) GetNextAttemptTime() *metav1.Time {
+	return s.NextAttemptTime
+}
+
 // GetConditions returns the conditions
 func (s *RebootNodeStatus) GetConditions() []metav1.Condition {
 	return s.Conditions
 }
 
+// Trim bounds the status lists before a status write, see MaxStatusConditions
+func (s *RebootNodeStatus) Trim() {
+	s.Conditions = trimConditions(s.Conditions)
+}
+
 func init() {
 	SchemeBuilder.Register(&RebootNode{}, &RebootNodeList{})
 }
@@ -126,6 +126,138 @@ func TestRebootNode_GetCSPReqRef(t *testing.T) {
 	}
 }
 
+func TestRebootNode_Outcome(t *testing.T) {
+	completed := metav1.Now()
+
+	tests := []struct {
+		name           string
+		conditions     []metav1.Condition
+		completionTime *metav1.Time
+		succeeded      bool
+		failed         bool
+		failureReason  string
+	}{
+		{
+			name: "in progress",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "NotReady"},
+			},
+		},
+		{
+			name: "node ready but not completed",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+			},
+		},
+		{
+			name: "succeeded",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+			},
+			completionTime: &completed,
+			succeeded:      true,
+		},
+		{
+			name: "timed out",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "Timeout"},
+			},
+			completionTime: &completed,
+			failed:         true,
+			failureReason:  "Timeout",
+		},
+		{
+			name: "reboot signal failed",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionFalse, Reason: "Failed"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown, Reason: "Initializing"},
+			},
+			completionTime: &completed,
+			failed:         true,
+			failureReason:  "Failed",
+		},
+		{
+			name: "hard reboot escalation failed",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "NotReady"},
+				{Type: RebootNodeConditionEscalatedToHardReboot, Status: metav1.ConditionFalse, Reason: "Failed"},
+			},
+			completionTime: &completed,
+			failed:         true,
+			failureReason:  "Failed",
+		},
+		{
+			name: "excluded",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionUnknown, Reason: "Initializing"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown, Reason: "Initializing"},
+				{Type: RebootNodeConditionRebootExcluded, Status: metav1.ConditionTrue, Reason: "ExcludedByAnnotation"},
+			},
+			completionTime: &completed,
+		},
+		{
+			name:           "completed without conditions",
+			conditions:     []metav1.Condition{},
+			completionTime: &completed,
+			failed:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rn := &RebootNode{
+				Status: RebootNodeStatus{
+					Conditions:     tt.conditions,
+					CompletionTime: tt.completionTime,
+				},
+			}
+			assert.Equal(t, tt.succeeded, rn.IsSucceeded())
+			assert.Equal(t, tt.failed, rn.IsFailed())
+			assert.Equal(t, tt.failureReason, rn.FailureReason())
+		})
+	}
+}
+
+func TestRebootNode_CurrentCondition(t *testing.T) {
+	now := time.Now()
+
+	t.Run("no conditions", func(t *testing.T) {
+		rn := &RebootNode{}
+
+		assert.Nil(t, rn.CurrentCondition())
+	})
+
+	t.Run("returns the most recently transitioned condition", func(t *testing.T) {
+		rn := &RebootNode{
+			Status: RebootNodeStatus{
+				Conditions: []metav1.Condition{
+					{Type: RebootNodeConditionSignalSent, LastTransitionTime: metav1.NewTime(now.Add(-time.Minute))},
+					{Type: RebootNodeConditionNodeReady, LastTransitionTime: metav1.NewTime(now)},
+					{Type: RebootNodeConditionGPUReady, LastTransitionTime: metav1.NewTime(now.Add(-2 * time.Minute))},
+				},
+			},
+		}
+
+		current := rn.CurrentCondition()
+		require.NotNil(t, current)
+		assert.Equal(t, RebootNodeConditionNodeReady, current.Type)
+	})
+
+	t.Run("prefers the last recorded condition on equal transition times", func(t *testing.T) {
+		rn := &RebootNode{}
+		rn.SetInitialConditions()
+
+		current := rn.CurrentCondition()
+		require.NotNil(t, current)
+		assert.Equal(t, RebootNodeConditionNodeReady, current.Type)
+	})
+}
+
 func TestRebootNode_SetInitialConditions(t *testing.T) {
 	t.Run("adds conditions when none exist", func(t *testing.T) {
 		rn := &RebootNode{}
@@ -221,6 +353,102 @@ func TestRebootNode_SetCompletionTime(t *testing.T) {
 	})
 }
 
+func TestRebootNode_SetNextAttemptTime(t *testing.T) {
+	t.Run("sets next attempt time from requeue delay", func(t *testing.T) {
+		rn := &RebootNode{}
+
+		rn.SetNextAttemptTime(2 * time.Minute)
+
+		require.NotNil(t, rn.Status.NextAttemptTime)
+		assert.WithinDuration(t, time.Now().Add(2*time.Minute), rn.Status.NextAttemptTime.Time, 5*time.Second)
+	})
+
+	t.Run("keeps a next attempt time close to the new one", func(t *testing.T) {
+		next := metav1.NewTime(time.Now().Add(100 * time.Second))
+		rn := &RebootNode{Status: RebootNodeStatus{NextAttemptTime: &next}}
+
+		rn.SetNextAttemptTime(2 * time.Minute)
+
+		assert.Equal(t, next, *rn.Status.NextAttemptTime)
+	})
+
+	t.Run("moves a next attempt time far from the new one", func(t *testing.T) {
+		next := metav1.NewTime(time.Now().Add(10 * time.Second))
+		rn := &RebootNode{Status: RebootNodeStatus{NextAttemptTime: &next}}
+
+		rn.SetNextAttemptTime(2 * time.Minute)
+
+		assert.WithinDuration(t, time.Now().Add(2*time.Minute), rn.Status.NextAttemptTime.Time, 5*time.Second)
+	})
+
+	t.Run("clears next attempt time when no requeue is scheduled", func(t *testing.T) {
+		next := metav1.NewTime(time.Now().Add(time.Minute))
+		rn := &RebootNode{Status: RebootNodeStatus{NextAttemptTime: &next}}
+
+		rn.SetNextAttemptTime(0)
+
+		assert.Nil(t, rn.Status.NextAttemptTime)
+	})
+
+	t.Run("clears next attempt time on terminal state", func(t *testing.T) {
+		next := metav1.NewTime(time.Now().Add(time.Minute))
+		rn := &RebootNode{Status: RebootNodeStatus{NextAttemptTime: &next}}
+		rn.SetCompletionTime()
+
+		rn.SetNextAttemptTime(time.Minute)
+
+		assert.Nil(t, rn.Status.NextAttemptTime)
+	})
+}
+
+func TestRebootNode_IsRebootObserved(t *testing.T) {
+	tests := []struct {
+		name          string
+		nodeReady     bool
+		preBootID     string
+		currentBootID string
+		expected      bool
+	}{
+		{
+			name:          "node was ready before reboot",
+			nodeReady:     true,
+			preBootID:     "boot-1",
+			currentBootID: "boot-1",
+			expected:      true,
+		},
+		{
+			name:          "node was not ready and boot ID unchanged",
+			nodeReady:     false,
+			preBootID:     "boot-1",
+			currentBootID: "boot-1",
+			expected:      false,
+		},
+		{
+			name:          "node was not ready and boot ID changed",
+			nodeReady:     false,
+			preBootID:     "boot-1",
+			currentBootID: "boot-2",
+			expected:      true,
+		},
+		{
+			name:          "node was not ready and boot ID unknown",
+			nodeReady:     false,
+			preBootID:     "",
+			currentBootID: "boot-2",
+			expected:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rn := &RebootNode{}
+			rn.RecordPreRebootState(tt.nodeReady, tt.preBootID)
+
+			assert.Equal(t, tt.expected, rn.IsRebootObserved(tt.currentBootID))
+		})
+	}
+}
+
 func TestRebootNode_StatusFields(t *testing.T) {
 	t.Run("status fields are initialized to zero values", func(t *testing.T) {
 		rn := &RebootNode{}
@@ -264,3 +492,80 @@ func TestRebootNode_StatusFields(t *testing.T) {
 		assert.Len(t, rn.Status.Conditions, 2) // SignalSent and NodeReady
 	})
 }
+
+func TestRebootNode_PhaseDurations(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	signalSent := metav1.NewTime(created.Add(10 * time.Minute))
+	completed := metav1.NewTime(created.Add(25 * time.Minute))
+	succeeded := []metav1.Condition{
+		{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+		{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+	}
+	timedOut := []metav1.Condition{
+		{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+		{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "Timeout"},
+	}
+
+	tests := []struct {
+		name        string
+		status      RebootNodeStatus
+		queued      time.Duration
+		queuedOk    bool
+		rebooting   time.Duration
+		rebootingOk bool
+	}{
+		{
+			name: "signal not sent",
+		},
+		{
+			name:     "rebooting",
+			status:   RebootNodeStatus{SignalSentTime: &signalSent},
+			queued:   10 * time.Minute,
+			queuedOk: true,
+		},
+		{
+			name:        "succeeded",
+			status:      RebootNodeStatus{SignalSentTime: &signalSent, CompletionTime: &completed, Conditions: succeeded},
+			queued:      10 * time.Minute,
+			queuedOk:    true,
+			rebooting:   15 * time.Minute,
+			rebootingOk: true,
+		},
+		{
+			name:     "timed out",
+			status:   RebootNodeStatus{SignalSentTime: &signalSent, CompletionTime: &completed, Conditions: timedOut},
+			queued:   10 * time.Minute,
+			queuedOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rn := &RebootNode{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Status:     tt.status,
+			}
+
+			queued, ok := rn.QueuedDuration()
+			assert.Equal(t, tt.queuedOk, ok)
+			assert.Equal(t, tt.queued, queued)
+
+			rebooting, ok := rn.RebootingDuration()
+			assert.Equal(t, tt.rebootingOk, ok)
+			assert.Equal(t, tt.rebooting, rebooting)
+		})
+	}
+}
+
+func TestRebootNode_SetSignalSentTime(t *testing.T) {
+	first := metav1.NewTime(time.Now().Add(-time.Minute))
+	rn := &RebootNode{Status: RebootNodeStatus{SignalSentTime: &first}}
+
+	// An escalated hard reboot keeps the time of the first signal
+	rn.SetSignalSentTime()
+	assert.Equal(t, &first, rn.Status.SignalSentTime)
+
+	rn = &RebootNode{}
+	rn.SetSignalSentTime()
+	require.NotNil(t, rn.Status.SignalSentTime)
+}
@@ -47,6 +47,10 @@ type TerminateNodeStatus struct {
 	// CompletionTime is the time when the termination was completed
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
+	// DrainCompletedTime is the time when the evicted pods had all left the node
+	// The drain duration is recorded once, when it is first set
+	DrainCompletedTime *metav1.Time `json:"drainCompletedTime,omitempty"`
+
 	// RetryCount tracks the number of reconciliation attempts for this terminate operation
 	// Used to implement maximum retry limits to prevent indefinite reconciliation
 	RetryCount int32 `json:"retryCount,omitempty"`
@@ -214,6 +218,11 @@ func (s *TerminateNodeStatus) GetConditions() []metav1.Condition {
 	return s.Conditions
 }
 
+// Trim bounds the status lists before a status write, see MaxStatusConditions
+func (s *TerminateNodeStatus) Trim() {
+	s.Conditions = trimConditions(s.Conditions)
+}
+
 func init() {
 	SchemeBuilder.Register(&TerminateNode{}, &TerminateNodeList{})
 }
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -148,7 +149,7 @@ func (in *RebootNode) DeepCopyInto(out *RebootNode) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -205,6 +206,16 @@ func (in *RebootNodeList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RebootNodeSpec) DeepCopyInto(out *RebootNodeSpec) {
 	*out = *in
+	if in.PreRebootJobTemplate != nil {
+		in, out := &in.PreRebootJobTemplate, &out.PreRebootJobTemplate
+		*out = new(batchv1.JobTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostRebootJobTemplate != nil {
+		in, out := &in.PostRebootJobTemplate, &out.PostRebootJobTemplate
+		*out = new(batchv1.JobTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootNodeSpec.
@@ -224,10 +235,26 @@ func (in *RebootNodeStatus) DeepCopyInto(out *RebootNodeStatus) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.SignalSentTime != nil {
+		in, out := &in.SignalSentTime, &out.SignalSentTime
+		*out = (*in).DeepCopy()
+	}
 	if in.CompletionTime != nil {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PreRebootKubeletStartTime != nil {
+		in, out := &in.PreRebootKubeletStartTime, &out.PreRebootKubeletStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadySince != nil {
+		in, out := &in.ReadySince, &out.ReadySince
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -332,6 +359,10 @@ func (in *TerminateNodeStatus) DeepCopyInto(out *TerminateNodeStatus) {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.DrainCompletedTime != nil {
+		in, out := &in.DrainCompletedTime, &out.DrainCompletedTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -43,8 +43,11 @@ import (
 	"github.com/nvidia/nvsentinel/commons/pkg/logger"
 	"github.com/nvidia/nvsentinel/commons/pkg/server"
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/audit"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/controller"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/notification"
 	webhookv1alpha1 "github.com/nvidia/nvsentinel/janitor/pkg/webhook/v1alpha1"
 )
 
@@ -89,6 +92,7 @@ func run() error {
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
 		configFile                                       string
+		printBackoffSchedule                             int
 		// Leader election tuning parameters
 		leaseDuration time.Duration
 		renewDeadline time.Duration
@@ -114,6 +118,8 @@ func run() error {
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&configFile, "config", "", "The path to the configuration file.")
+	flag.IntVar(&printBackoffSchedule, "print-backoff-schedule", 0,
+		"If set, print the requeue delays for this many consecutive failures and exit.")
 
 	// Leader election flags
 	// Defaulting to pretty high values, we were hitting some crashes
@@ -128,6 +134,10 @@ func run() error {
 
 	flag.Parse()
 
+	if printBackoffSchedule > 0 {
+		return controller.PrintBackoffSchedule(os.Stdout, int32(printBackoffSchedule)) // nolint:gosec // operator input
+	}
+
 	slog.Info("Parsed flags",
 		"metrics-bind-address", metricsAddr,
 		"health-probe-bind-address", probeAddr,
@@ -143,6 +153,15 @@ func run() error {
 		return err
 	}
 
+	if cfg.Global.MetricsNodeLabel != "" {
+		if err = metrics.GlobalMetrics.SetNodeLabelMode(cfg.Global.MetricsNodeLabel); err != nil {
+			slog.Error("Unable to configure metrics", "error", err)
+			return err
+		}
+	}
+
+	metrics.GlobalMetrics.SetNodePoolLabel(cfg.Global.MetricsNodePoolLabel)
+
 	slog.Info("Loaded configuration",
 		"rebootNode.enabled", cfg.RebootNode.Enabled,
 		"rebootNode.timeout", cfg.RebootNode.Timeout,
@@ -283,11 +302,48 @@ func run() error {
 
 	slog.Info("Manager created successfully")
 
+	// Setup reboot outcome notifications
+	var rebootNotifier notification.NotificationSink
+
+	queuedSink, err := notification.NewSinkFromConfig(cfg.RebootNode.Notification)
+	if err != nil {
+		slog.Error("Unable to create reboot notification sink", "error", err)
+		return err
+	}
+
+	if queuedSink != nil {
+		if err = mgr.Add(queuedSink); err != nil {
+			slog.Error("Unable to add reboot notification sink to manager", "error", err)
+			return err
+		}
+
+		rebootNotifier = queuedSink
+
+		slog.Info("Reboot outcome notifications enabled")
+	}
+
+	// Setup reboot decision audit trail
+	auditLogger, err := audit.NewLoggerFromConfig(cfg.RebootNode.Audit)
+	if err != nil {
+		slog.Error("Unable to create reboot audit logger", "error", err)
+		return err
+	}
+
+	if auditLogger != nil {
+		defer auditLogger.Close()
+
+		slog.Info("Reboot audit trail enabled", "file", cfg.RebootNode.Audit.FilePath)
+	}
+
 	// Setup RebootNode controller
 	if err = (&controller.RebootNodeReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		Config: &cfg.RebootNode,
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
+		Scheme:    mgr.GetScheme(),
+		Config:    &cfg.RebootNode,
+		Notifier:  rebootNotifier,
+		Auditor:   auditLogger,
+		Recorder:  mgr.GetEventRecorderFor("janitor"),
 	}).SetupWithManager(mgr); err != nil {
 		slog.Error("Unable to create controller", "controller", "RebootNode", "error", err)
 		return err
@@ -305,6 +361,23 @@ func run() error {
 
 	slog.Info("RebootNode and TerminateNode controllers registered")
 
+	// Setup AutoReboot controller
+	if cfg.AutoReboot.Enabled {
+		if err = (&controller.AutoRebootReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Config: &cfg.AutoReboot,
+		}).SetupWithManager(mgr); err != nil {
+			slog.Error("Unable to create controller", "controller", "AutoReboot", "error", err)
+			return err
+		}
+
+		slog.Info("AutoReboot controller registered",
+			"triggerCondition", cfg.AutoReboot.TriggerCondition,
+			"triggerLabel", cfg.AutoReboot.TriggerLabel,
+			"triggerTaint", cfg.AutoReboot.TriggerTaint)
+	}
+
 	// Setup unified webhook for all Janitor CRDs
 	if err = webhookv1alpha1.SetupJanitorWebhookWithManager(mgr, cfg); err != nil {
 		slog.Error("Unable to create webhook", "webhook", "Janitor", "error", err)
@@ -0,0 +1,105 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit writes an append-only trail of janitor reboot decisions, separate from the operational logs.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+// ActorJanitor identifies transitions decided by the janitor controllers
+const ActorJanitor = "janitor"
+
+// Record describes one condition transition of a janitor resource
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	Resource  string    `json:"resource"`
+	Name      string    `json:"name"`
+	Node      string    `json:"node"`
+	Condition string    `json:"condition"`
+	FromState string    `json:"fromState"`
+	ToState   string    `json:"toState"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message,omitempty"`
+	Actor     string    `json:"actor"`
+}
+
+// Logger writes each record as a single JSON line. It is safe for concurrent use.
+type Logger struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// NewLogger returns a logger writing records to w
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{encoder: json.NewEncoder(w)}
+}
+
+// NewLoggerFromConfig returns a logger for the destination selected in cfg, or nil when auditing is disabled.
+// Records go to stdout unless a file is configured, which is opened for appending.
+func NewLoggerFromConfig(cfg config.AuditConfig) (*Logger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	if cfg.FilePath == "" {
+		return NewLogger(os.Stdout), nil
+	}
+
+	file, err := os.OpenFile(cfg.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+
+	logger := NewLogger(file)
+	logger.closer = file
+
+	return logger, nil
+}
+
+// Log writes the record, stamping it with the current time when it has none
+func (l *Logger) Log(record Record) error {
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+
+	record.Timestamp = record.Timestamp.UTC()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.encoder.Encode(record); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the audit log file, if any
+func (l *Logger) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+
+	return l.closer.Close()
+}
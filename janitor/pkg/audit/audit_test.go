@@ -0,0 +1,104 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+func TestLogger_WritesOneJSONRecordPerLine(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := NewLogger(&buf)
+
+	require.NoError(t, logger.Log(Record{
+		Timestamp: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Node:      "node-1",
+		Condition: "SignalSent",
+		ToState:   "True",
+		Reason:    "Succeeded",
+		Actor:     ActorJanitor,
+	}))
+	require.NoError(t, logger.Log(Record{Node: "node-1", FromState: "Unknown", ToState: "True"}))
+
+	scanner := bufio.NewScanner(&buf)
+
+	var records []Record
+
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "SignalSent", records[0].Condition)
+	assert.Equal(t, "2025-01-02T03:04:05Z", records[0].Timestamp.Format(time.RFC3339))
+	assert.Equal(t, ActorJanitor, records[0].Actor)
+	assert.False(t, records[1].Timestamp.IsZero(), "a missing timestamp is stamped with the current time")
+}
+
+func TestNewLoggerFromConfig(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		logger, err := NewLoggerFromConfig(config.AuditConfig{FilePath: "/unused"})
+		require.NoError(t, err)
+		assert.Nil(t, logger)
+	})
+
+	t.Run("stdout by default", func(t *testing.T) {
+		logger, err := NewLoggerFromConfig(config.AuditConfig{Enabled: true})
+		require.NoError(t, err)
+		require.NotNil(t, logger)
+		assert.NoError(t, logger.Close())
+	})
+
+	t.Run("appends to file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		require.NoError(t, os.WriteFile(path, []byte("{\"node\":\"earlier\"}\n"), 0600))
+
+		logger, err := NewLoggerFromConfig(config.AuditConfig{Enabled: true, FilePath: path})
+		require.NoError(t, err)
+		require.NoError(t, logger.Log(Record{Node: "node-1"}))
+		require.NoError(t, logger.Close())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		lines := bytes.Split(bytes.TrimSpace(content), []byte("\n"))
+		require.Len(t, lines, 2)
+		assert.Contains(t, string(lines[0]), "earlier")
+		assert.Contains(t, string(lines[1]), "node-1")
+	})
+
+	t.Run("unwritable file", func(t *testing.T) {
+		_, err := NewLoggerFromConfig(config.AuditConfig{
+			Enabled:  true,
+			FilePath: filepath.Join(t.TempDir(), "missing", "audit.log"),
+		})
+		assert.Error(t, err)
+	})
+}
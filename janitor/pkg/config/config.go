@@ -16,10 +16,12 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Config represents the janitor configuration structure
@@ -27,6 +29,7 @@ type Config struct {
 	Global        GlobalConfig                  `mapstructure:"global" json:"global"`
 	RebootNode    RebootNodeControllerConfig    `mapstructure:"rebootNodeController" json:"rebootNodeController"`
 	TerminateNode TerminateNodeControllerConfig `mapstructure:"terminateNodeController" json:"terminateNodeController"`
+	AutoReboot    AutoRebootControllerConfig    `mapstructure:"autoRebootController" json:"autoRebootController"`
 }
 
 // GlobalConfig contains global janitor settings
@@ -34,6 +37,12 @@ type GlobalConfig struct {
 	Timeout    time.Duration `mapstructure:"timeout" json:"timeout"`
 	ManualMode bool          `mapstructure:"manualMode" json:"manualMode"`
 	Nodes      NodeConfig    `mapstructure:"nodes" json:"nodes"`
+	// MetricsNodeLabel bounds the node label cardinality of the action metrics: PerNode, None or
+	// UnsucceededOnly; defaults to PerNode when empty
+	MetricsNodeLabel string `mapstructure:"metricsNodeLabel" json:"metricsNodeLabel"`
+	// MetricsNodePoolLabel is the only node label whose value is copied into the node_pool label of
+	// the action metrics, e.g. for per-pool dashboards; the node_pool label is empty when unset
+	MetricsNodePoolLabel string `mapstructure:"metricsNodePoolLabel" json:"metricsNodePoolLabel"`
 }
 
 // NodeConfig contains configuration for nodes
@@ -52,6 +61,224 @@ type RebootNodeControllerConfig struct {
 	// NodeExclusions defines label selectors for nodes that should be excluded from reboot operations
 	// Nodes matching any of these label selectors will be rejected by the admission webhook
 	NodeExclusions []metav1.LabelSelector
+	// FinalizerName overrides the finalizer added to RebootNode objects
+	// Separate janitor instances managing disjoint node sets must use distinct finalizers
+	// Must be a qualified name such as example.com/name; the config fails to load otherwise
+	// Defaults to the built-in RebootNode finalizer when empty
+	FinalizerName string
+	// SLA is the time within which a reboot should complete, measured from RebootNode creation
+	// Reboots completing later are marked as SLA breaches; disabled when zero
+	SLA time.Duration
+	// AllowControlPlane allows rebooting nodes with a control-plane role label; otherwise only
+	// RebootNodes annotated with janitor.dgxc.nvidia.com/allow-control-plane may reboot them
+	AllowControlPlane bool
+	// FirstCheckDelay is how long after a reboot signal is sent the node is first checked, for CSPs
+	// where a node takes minutes to start shutting down. Later checks back off as usual
+	// Defaults to 30 seconds when zero
+	FirstCheckDelay time.Duration
+	// EscalateToHardReboot retries a soft reboot that timed out once as a hard reboot before failing
+	EscalateToHardReboot bool
+	// PostSuccessVerifyDelay requires a node found ready after the reboot to still be ready this long
+	// later before the reboot succeeds; the reboot fails if the node stops being ready in between
+	// The delay does not count towards the reboot retry limit
+	// Disabled when zero
+	PostSuccessVerifyDelay time.Duration
+	// ReadyStabilityWindow requires a rebooted node to be found ready in every check for this long
+	// before the reboot succeeds. Unlike PostSuccessVerifyDelay, a node found not ready in between
+	// restarts the window rather than failing the reboot, which still times out as usual. The checks
+	// of a ready node within the window do not count towards the reboot retry limit.
+	// Disabled when zero
+	ReadyStabilityWindow time.Duration
+	// ReadinessMode is ReadinessModeBoth, ReadinessModeCSPOnly or ReadinessModeKubernetesOnly
+	// Defaults to ReadinessModeBoth when empty
+	ReadinessMode string
+	// VerificationStrategy selects how a ready node is proven to have rebooted: VerificationStrategyBootID,
+	// VerificationStrategyKubeletStartTime or VerificationStrategyNone
+	// Defaults to VerificationStrategyBootID when empty, not VerificationStrategyNone: the boot ID check
+	// of a node that was already NotReady predates the strategies, and defaulting to none would silently
+	// turn it off on upgrade
+	VerificationStrategy string
+	// GPUReadiness requires the node GPUs to be available before a reboot is declared successful
+	GPUReadiness GPUReadinessConfig
+	// Hooks configures the Jobs run from the RebootNode hook job templates
+	Hooks RebootHooksConfig
+	// LivenessWindow reports the janitor unhealthy when no RebootNode reconcile completed within it
+	// while unfinished RebootNodes exist, e.g. because every worker is stuck in a CSP call
+	// Must exceed the 5 minute maximum requeue delay; disabled when zero and in manual mode
+	LivenessWindow time.Duration
+	// DegradedCluster holds timed out reboots instead of failing them while the cluster is degraded
+	DegradedCluster DegradedClusterConfig
+	// Admission limits how many RebootNodes reboot at the same time
+	Admission RebootAdmissionConfig
+	// Budget limits how many reboots start within a rolling window
+	Budget RebootBudgetConfig
+	// SpareCapacity defers reboots that would leave the cluster short of GPU capacity
+	SpareCapacity SpareCapacityConfig
+	// NodeCondition reports the reboot lifecycle as a condition on the target node
+	NodeCondition NodeConditionConfig
+	// RebootCountAnnotation is the node annotation counting the successful reboots by the janitor
+	// Defaults to janitor.dgxc.nvidia.com/reboot-count when empty
+	RebootCountAnnotation string
+	// Notification configures where the outcome of every completed RebootNode is sent
+	Notification NotificationConfig
+	// CSPRetry retries the CSP reboot status and node readiness checks within a reconcile on
+	// transient errors such as a reset connection
+	CSPRetry CSPRetryConfig
+	// Audit writes a record of every RebootNode condition transition for compliance
+	Audit AuditConfig
+	// ServerSideApplyStatus writes the RebootNode status with server-side apply under the janitor
+	// field manager instead of an update, so that writes do not conflict on the resource version
+	// Status fields last written by an update are not removed by a later apply, so enable this on
+	// new RebootNodes or together with clearing their status field managers
+	ServerSideApplyStatus bool
+	// DeletionTimeout bounds the check for, and cancellation of, a CSP operation still pending when a
+	// RebootNode is deleted, so that a slow provider does not hold the finalizer
+	// Defaults to 30 seconds when zero
+	DeletionTimeout time.Duration
+	// WatchNodes reconciles a RebootNode as soon as its node turns Ready or reports a new boot ID,
+	// rather than on the next poll. Without permission to list and watch nodes, the janitor logs a
+	// warning and keeps polling. Every reconcile of a reboot in progress counts towards its retries.
+	WatchNodes bool
+}
+
+// AuditConfig contains configuration for the audit trail of reboot decisions
+type AuditConfig struct {
+	// Enabled writes an audit record for every RebootNode condition transition
+	Enabled bool
+	// FilePath appends the records to this file instead of writing them to stdout
+	FilePath string
+}
+
+// Readiness reports a rebooted node needs before its reboot succeeds
+const (
+	// ReadinessModeBoth requires the CSP to report the instance ready and Kubernetes to report the node Ready
+	ReadinessModeBoth = "both"
+	// ReadinessModeCSPOnly only requires the CSP to report the instance ready
+	ReadinessModeCSPOnly = "csp-only"
+	// ReadinessModeKubernetesOnly only requires Kubernetes to report the node Ready after a new boot,
+	// for providers whose instance status reports ready before the node is usable
+	ReadinessModeKubernetesOnly = "k8s-only"
+)
+
+// How a ready node is proven to have rebooted since the reboot signal was sent
+const (
+	// VerificationStrategyBootID requires a new boot ID when the node was already NotReady before the signal
+	VerificationStrategyBootID = "boot-id"
+	// VerificationStrategyKubeletStartTime requires the kubelet start time to advance past the one seen
+	// at signal time, for environments where the boot ID is not reported
+	VerificationStrategyKubeletStartTime = "kubelet-start-time"
+	// VerificationStrategyNone trusts the readiness reports alone
+	VerificationStrategyNone = "none"
+)
+
+// CSPRetryConfig contains configuration for retrying idempotent CSP calls within a single call.
+// Reboot signals are not retried in the client; they are retried by the controller backoff.
+type CSPRetryConfig struct {
+	// MaxRetries is the number of retries after a transient failure; disabled when zero
+	MaxRetries int
+	// InitialDelay is the delay before the first retry, doubling with every retry
+	// Defaults to 500ms when zero
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between retries; defaults to 5 seconds when zero
+	MaxDelay time.Duration
+}
+
+// NotificationConfig contains configuration for delivering reboot outcomes to an external sink
+type NotificationConfig struct {
+	// WebhookURL receives a JSON POST for every RebootNode that reaches a terminal state
+	WebhookURL string
+	// GRPCTarget receives a RebootOutcome message over gRPC instead; mutually exclusive with WebhookURL
+	// Notifications are disabled when neither is set
+	GRPCTarget string
+	// Timeout bounds a single delivery attempt
+	Timeout time.Duration
+	// MaxRetries is the number of redeliveries attempted after a failed delivery
+	MaxRetries int
+	// QueueSize bounds the number of outcomes awaiting delivery; further outcomes are dropped
+	QueueSize int
+}
+
+// GPUReadinessConfig contains configuration for waiting on the node GPUs after a rebooted node reports Ready.
+// The kubelet reports Ready before the GPU driver and DCGM pods are back, so NodeReady alone does not
+// mean the node can run GPU workloads.
+type GPUReadinessConfig struct {
+	// Enabled requires a non-zero nvidia.com/gpu allocatable or a true driver.installed label on the node
+	Enabled bool
+	// Timeout bounds the wait for the GPUs once the node is Ready; the reboot fails when it elapses
+	// The checks made while waiting do not count towards the reboot retry limit
+	// Defaults to 10 minutes when zero
+	Timeout time.Duration
+}
+
+// RebootHooksConfig contains configuration for the Jobs the controller runs around a reboot from the
+// RebootNode job templates
+type RebootHooksConfig struct {
+	// Namespace the hook Jobs are created in; a job template setting another namespace fails the reboot
+	// Defaults to "default" when empty
+	Namespace string
+	// JobTimeout bounds how long a hook Job may run; the reboot fails when it elapses
+	// Waiting for the post-reboot Job does not count towards the reboot retry limit
+	// Defaults to 10 minutes when zero
+	JobTimeout time.Duration
+}
+
+// DegradedClusterConfig contains configuration for holding reboots during control-plane incidents.
+// When the control plane loses contact with the kubelets, every node reports an Unknown Ready condition
+// and in-progress reboots would time out even though the nodes rebooted fine.
+type DegradedClusterConfig struct {
+	// UnknownNodesPercent is the share of the other nodes that must report an Unknown Ready condition,
+	// together with the rebooted node, for a timed out reboot to be held; disabled when zero
+	UnknownNodesPercent int
+}
+
+// NodeConditionConfig contains configuration for reporting the reboot lifecycle on the target node,
+// for tooling that watches node conditions rather than RebootNodes. The condition is True with reason
+// InProgress once the reboot signal is sent, and False with reason Completed, Failed or Abandoned once
+// the RebootNode completes or is deleted.
+type NodeConditionConfig struct {
+	// Enabled sets the condition on the target node
+	Enabled bool
+	// Type is the node condition type; defaults to JanitorReboot when empty
+	Type string
+}
+
+// Orders in which RebootNodes waiting for a reboot slot are admitted, by their spec priority
+const (
+	// PriorityOrderHighestFirst admits the RebootNode with the highest priority first
+	PriorityOrderHighestFirst = "HighestFirst"
+	// PriorityOrderLowestFirst admits the RebootNode with the lowest priority first, e.g. to reboot
+	// less critical nodes first when priorities reflect the node workloads
+	PriorityOrderLowestFirst = "LowestFirst"
+)
+
+// RebootAdmissionConfig contains configuration for limiting concurrent reboots across the cluster.
+// A RebootNode holds a reboot slot from its pre-reboot Job or reboot signal until it completes; the
+// others wait and are admitted by priority, then by creation time, as slots free up.
+type RebootAdmissionConfig struct {
+	// MaxConcurrentReboots is the number of RebootNodes that may hold a reboot slot; unlimited when zero
+	MaxConcurrentReboots int
+	// PriorityOrder is PriorityOrderHighestFirst or PriorityOrderLowestFirst
+	// Defaults to PriorityOrderHighestFirst when empty
+	PriorityOrder string
+}
+
+// RebootBudgetConfig contains configuration for limiting the reboots started across the cluster within a
+// rolling window, e.g. at most 10 reboots per hour. Reboots beyond the budget are deferred until the
+// oldest reboot of the window leaves it.
+type RebootBudgetConfig struct {
+	// MaxReboots is the number of reboots that may start within Window; unlimited when zero
+	MaxReboots int
+	// Window is the rolling window the budget applies to; the budget is disabled when zero
+	Window time.Duration
+}
+
+// SpareCapacityConfig contains configuration for the pre-flight check that the cluster can absorb the
+// rebooted node. Reboots are deferred while the other ready nodes advertise fewer allocatable GPUs
+// than required.
+type SpareCapacityConfig struct {
+	// MinSpareGPUs is the number of allocatable GPUs the other ready nodes must keep while a node
+	// reboots; the check is disabled when zero
+	MinSpareGPUs int64
 }
 
 // TerminateNodeControllerConfig contains configuration for terminate node controller
@@ -67,9 +294,30 @@ type TerminateNodeControllerConfig struct {
 	NodeExclusions []metav1.LabelSelector
 }
 
+// AutoRebootControllerConfig contains configuration for the controller that creates RebootNode
+// objects for nodes reporting a trigger condition or label
+type AutoRebootControllerConfig struct {
+	// Enabled indicates if the controller is enabled
+	Enabled bool
+	// TriggerCondition is the node condition type that triggers a reboot while its status is True
+	TriggerCondition string
+	// TriggerLabel is the node label key that triggers a reboot while it is present
+	TriggerLabel string
+	// TriggerLabelValue restricts TriggerLabel to a specific value; any value matches when empty
+	TriggerLabelValue string
+	// TriggerTaint is the node taint key that triggers a reboot while the node has it; the taint is
+	// removed once the reboot succeeds
+	TriggerTaint string
+	// NodeSelector restricts the nodes that are rebooted automatically; all nodes match when empty
+	NodeSelector metav1.LabelSelector
+	// NodeExclusions defines label selectors for nodes that should never be rebooted automatically
+	NodeExclusions []metav1.LabelSelector
+}
+
 // LoadConfig loads configuration from a YAML file using Viper
 func LoadConfig(configPath string) (*Config, error) {
-	v := viper.New()
+	// Label keys such as nvidia.com/gpu.present contain dots, which viper would otherwise treat as nesting
+	v := viper.NewWithOptions(viper.KeyDelimiter("::"))
 
 	if configPath != "" {
 		v.SetConfigFile(configPath)
@@ -94,9 +342,38 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	switch config.RebootNode.Admission.PriorityOrder {
+	case "", PriorityOrderHighestFirst, PriorityOrderLowestFirst:
+	default:
+		return nil, fmt.Errorf("invalid reboot priority order %q: must be %s or %s",
+			config.RebootNode.Admission.PriorityOrder, PriorityOrderHighestFirst, PriorityOrderLowestFirst)
+	}
+
+	switch config.RebootNode.ReadinessMode {
+	case "", ReadinessModeBoth, ReadinessModeCSPOnly, ReadinessModeKubernetesOnly:
+	default:
+		return nil, fmt.Errorf("invalid readiness mode %q: must be %s, %s or %s",
+			config.RebootNode.ReadinessMode, ReadinessModeBoth, ReadinessModeCSPOnly, ReadinessModeKubernetesOnly)
+	}
+
+	switch config.RebootNode.VerificationStrategy {
+	case "", VerificationStrategyBootID, VerificationStrategyKubeletStartTime, VerificationStrategyNone:
+	default:
+		return nil, fmt.Errorf("invalid verification strategy %q: must be %s, %s or %s",
+			config.RebootNode.VerificationStrategy, VerificationStrategyBootID, VerificationStrategyKubeletStartTime,
+			VerificationStrategyNone)
+	}
+
+	if name := config.RebootNode.FinalizerName; name != "" {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid finalizer name %q: %s", name, strings.Join(errs, "; "))
+		}
+	}
+
 	// Apply node exclusions from global config to controller-specific configs
 	config.RebootNode.NodeExclusions = config.Global.Nodes.Exclusions
 	config.TerminateNode.NodeExclusions = config.Global.Nodes.Exclusions
+	config.AutoReboot.NodeExclusions = config.Global.Nodes.Exclusions
 
 	return &config, nil
 }
@@ -41,6 +41,8 @@ func TestLoadConfig_ValidYAML(t *testing.T) {
 global:
   timeout: 30m
   manualMode: true
+  metricsNodeLabel: UnsucceededOnly
+  metricsNodePoolLabel: example.com/node-pool
   nodes:
     exclusions:
       - matchLabels:
@@ -51,11 +53,64 @@ rebootNodeController:
   enabled: true
   manualMode: false
   timeout: 20m
+  finalizerName: janitor.dgxc.nvidia.com/instance-b
+  escalateToHardReboot: true
+  allowControlPlane: true
+  livenessWindow: 15m
+  postSuccessVerifyDelay: 1m
+  readyStabilityWindow: 2m
+  gpuReadiness:
+    enabled: true
+    timeout: 15m
+  hooks:
+    namespace: nvsentinel
+    jobTimeout: 5m
+  degradedCluster:
+    unknownNodesPercent: 60
+  nodeCondition:
+    enabled: true
+    type: NodeRebooting
+  rebootCountAnnotation: example.com/reboots
+  admission:
+    maxConcurrentReboots: 2
+    priorityOrder: LowestFirst
+  budget:
+    maxReboots: 10
+    window: 1h
+  spareCapacity:
+    minSpareGPUs: 64
+  sla: 45m
+  readinessMode: k8s-only
+  verificationStrategy: kubelet-start-time
+  serverSideApplyStatus: true
+  deletionTimeout: 45s
+  firstCheckDelay: 3m
+  watchNodes: true
+  notification:
+    webhookURL: https://incidents.example.com/janitor
+    timeout: 5s
+    maxRetries: 2
+    queueSize: 50
+  cspRetry:
+    maxRetries: 3
+    initialDelay: 250ms
+    maxDelay: 2s
+  audit:
+    enabled: true
+    filePath: /var/log/janitor/audit.log
 
 terminateNodeController:
   enabled: false
   manualMode: true
   timeout: 15m
+
+autoRebootController:
+  enabled: true
+  triggerCondition: GpuFallenOff
+  triggerTaint: nvidia.com/reboot-required
+  nodeSelector:
+    matchLabels:
+      nvidia.com/gpu.present: "true"
 `
 
 	err := os.WriteFile(configPath, []byte(configContent), 0644)
@@ -69,6 +124,8 @@ terminateNodeController:
 	// Verify global config
 	assert.Equal(t, 30*time.Minute, config.Global.Timeout)
 	assert.True(t, config.Global.ManualMode)
+	assert.Equal(t, "UnsucceededOnly", config.Global.MetricsNodeLabel)
+	assert.Equal(t, "example.com/node-pool", config.Global.MetricsNodePoolLabel)
 	assert.Len(t, config.Global.Nodes.Exclusions, 1)
 	assert.Equal(t, "production", config.Global.Nodes.Exclusions[0].MatchLabels["environment"])
 	assert.Equal(t, "true", config.Global.Nodes.Exclusions[0].MatchLabels["critical"])
@@ -77,15 +134,59 @@ terminateNodeController:
 	assert.True(t, config.RebootNode.Enabled)
 	assert.False(t, config.RebootNode.ManualMode)
 	assert.Equal(t, 20*time.Minute, config.RebootNode.Timeout)
+	assert.Equal(t, "janitor.dgxc.nvidia.com/instance-b", config.RebootNode.FinalizerName)
+	assert.True(t, config.RebootNode.EscalateToHardReboot)
+	assert.True(t, config.RebootNode.AllowControlPlane)
+	assert.Equal(t, 15*time.Minute, config.RebootNode.LivenessWindow)
+	assert.Equal(t, time.Minute, config.RebootNode.PostSuccessVerifyDelay)
+	assert.Equal(t, 2*time.Minute, config.RebootNode.ReadyStabilityWindow)
+	assert.True(t, config.RebootNode.GPUReadiness.Enabled)
+	assert.Equal(t, 15*time.Minute, config.RebootNode.GPUReadiness.Timeout)
+	assert.Equal(t, "nvsentinel", config.RebootNode.Hooks.Namespace)
+	assert.Equal(t, 5*time.Minute, config.RebootNode.Hooks.JobTimeout)
+	assert.Equal(t, 60, config.RebootNode.DegradedCluster.UnknownNodesPercent)
+	assert.Equal(t, 2, config.RebootNode.Admission.MaxConcurrentReboots)
+	assert.True(t, config.RebootNode.NodeCondition.Enabled)
+	assert.Equal(t, "NodeRebooting", config.RebootNode.NodeCondition.Type)
+	assert.Equal(t, "example.com/reboots", config.RebootNode.RebootCountAnnotation)
+	assert.Equal(t, PriorityOrderLowestFirst, config.RebootNode.Admission.PriorityOrder)
+	assert.Equal(t, 10, config.RebootNode.Budget.MaxReboots)
+	assert.Equal(t, time.Hour, config.RebootNode.Budget.Window)
+	assert.Equal(t, int64(64), config.RebootNode.SpareCapacity.MinSpareGPUs)
+	assert.Equal(t, 45*time.Minute, config.RebootNode.SLA)
+	assert.Equal(t, ReadinessModeKubernetesOnly, config.RebootNode.ReadinessMode)
+	assert.Equal(t, VerificationStrategyKubeletStartTime, config.RebootNode.VerificationStrategy)
+	assert.True(t, config.RebootNode.ServerSideApplyStatus)
+	assert.Equal(t, 45*time.Second, config.RebootNode.DeletionTimeout)
+	assert.Equal(t, 3*time.Minute, config.RebootNode.FirstCheckDelay)
+	assert.True(t, config.RebootNode.WatchNodes)
+	assert.True(t, config.RebootNode.Audit.Enabled)
+	assert.Equal(t, "/var/log/janitor/audit.log", config.RebootNode.Audit.FilePath)
+	assert.Equal(t, "https://incidents.example.com/janitor", config.RebootNode.Notification.WebhookURL)
+	assert.Equal(t, 5*time.Second, config.RebootNode.Notification.Timeout)
+	assert.Equal(t, 2, config.RebootNode.Notification.MaxRetries)
+	assert.Equal(t, 50, config.RebootNode.Notification.QueueSize)
+	assert.Empty(t, config.RebootNode.Notification.GRPCTarget)
+	assert.Equal(t, 3, config.RebootNode.CSPRetry.MaxRetries)
+	assert.Equal(t, 250*time.Millisecond, config.RebootNode.CSPRetry.InitialDelay)
+	assert.Equal(t, 2*time.Second, config.RebootNode.CSPRetry.MaxDelay)
 
 	// Verify TerminateNode config
 	assert.False(t, config.TerminateNode.Enabled)
 	assert.True(t, config.TerminateNode.ManualMode)
 	assert.Equal(t, 15*time.Minute, config.TerminateNode.Timeout)
 
+	// Verify AutoReboot config
+	assert.True(t, config.AutoReboot.Enabled)
+	assert.Equal(t, "GpuFallenOff", config.AutoReboot.TriggerCondition)
+	assert.Empty(t, config.AutoReboot.TriggerLabel)
+	assert.Equal(t, "nvidia.com/reboot-required", config.AutoReboot.TriggerTaint)
+	assert.Equal(t, "true", config.AutoReboot.NodeSelector.MatchLabels["nvidia.com/gpu.present"])
+
 	// Verify that node exclusions are propagated to controller configs
 	assert.Equal(t, config.Global.Nodes.Exclusions, config.RebootNode.NodeExclusions)
 	assert.Equal(t, config.Global.Nodes.Exclusions, config.TerminateNode.NodeExclusions)
+	assert.Equal(t, config.Global.Nodes.Exclusions, config.AutoReboot.NodeExclusions)
 }
 
 func TestLoadConfig_InvalidYAML(t *testing.T) {
@@ -109,6 +210,80 @@ global:
 	assert.Contains(t, err.Error(), "failed to unmarshal config")
 }
 
+func TestLoadConfig_InvalidPriorityOrder(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "invalid-priority-order.yaml")
+
+	content := `
+rebootNodeController:
+  admission:
+    maxConcurrentReboots: 2
+    priorityOrder: Random
+`
+
+	err := os.WriteFile(configPath, []byte(content), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath)
+	assert.Error(t, err)
+	assert.Nil(t, config)
+	assert.Contains(t, err.Error(), "invalid reboot priority order")
+}
+
+func TestLoadConfig_InvalidReadinessMode(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "invalid-readiness-mode.yaml")
+
+	content := `
+rebootNodeController:
+  readinessMode: any
+`
+
+	err := os.WriteFile(configPath, []byte(content), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath)
+	assert.Error(t, err)
+	assert.Nil(t, config)
+	assert.Contains(t, err.Error(), "invalid readiness mode")
+}
+
+func TestLoadConfig_InvalidVerificationStrategy(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "invalid-verification-strategy.yaml")
+
+	content := `
+rebootNodeController:
+  verificationStrategy: any
+`
+
+	err := os.WriteFile(configPath, []byte(content), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath)
+	assert.Error(t, err)
+	assert.Nil(t, config)
+	assert.Contains(t, err.Error(), "invalid verification strategy")
+}
+
+func TestLoadConfig_InvalidFinalizerName(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "invalid-finalizer-name.yaml")
+
+	content := `
+rebootNodeController:
+  finalizerName: janitor instance b
+`
+
+	err := os.WriteFile(configPath, []byte(content), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath)
+	assert.Error(t, err)
+	assert.Nil(t, config)
+	assert.Contains(t, err.Error(), "invalid finalizer name")
+}
+
 func TestLoadConfig_EmptyFile(t *testing.T) {
 	// Create an empty config file
 	tmpDir := t.TempDir()
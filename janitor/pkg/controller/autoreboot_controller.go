@@ -0,0 +1,235 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+// AutoRebootReconciler creates a RebootNode for nodes that report the configured trigger
+// condition, label or taint, so that health monitors can request reboots without a separate producer.
+type AutoRebootReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Config *config.AutoRebootControllerConfig
+}
+
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=rebootnodes,verbs=get;list;watch;create
+
+// Reconcile creates a RebootNode for the node if it is triggered and no reboot is already active.
+// The RebootNode name is derived from the trigger so that repeated events for the same trigger
+// occurrence never produce more than one RebootNode.
+func (r *AutoRebootReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var node corev1.Node
+	if err := r.Get(ctx, req.NamespacedName, &node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !r.isTriggered(&node) {
+		return ctrl.Result{}, nil
+	}
+
+	eligible, err := r.isEligible(&node)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !eligible {
+		logger.V(1).Info("node is triggered but not eligible for automatic reboot", "node", node.Name)
+		return ctrl.Result{}, nil
+	}
+
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := r.List(ctx, &rebootNodes); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list rebootnodes: %w", err)
+	}
+
+	rebootName, removeTaint := r.rebootNodeNameFor(&node, rebootNodes.Items)
+
+	for _, rebootNode := range rebootNodes.Items {
+		if rebootNode.Spec.NodeName != node.Name {
+			continue
+		}
+
+		// Either this trigger occurrence was already handled or another reboot is still running
+		if rebootNode.Name == rebootName || rebootNode.Status.CompletionTime == nil {
+			logger.V(1).Info("reboot already requested for node",
+				"node", node.Name,
+				"rebootNode", rebootNode.Name)
+
+			return ctrl.Result{}, nil
+		}
+	}
+
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: rebootName,
+		},
+		Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{
+			NodeName: node.Name,
+		},
+	}
+
+	if removeTaint {
+		rebootNode.Annotations = map[string]string{RemoveTaintAnnotation: r.Config.TriggerTaint}
+	}
+
+	if err := r.Create(ctx, rebootNode); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to create rebootnode for node %s: %w", node.Name, err)
+	}
+
+	logger.Info("created rebootnode for triggered node",
+		"node", node.Name,
+		"rebootNode", rebootName)
+
+	return ctrl.Result{}, nil
+}
+
+// isTriggered returns true if the node reports the trigger condition, label or taint
+func (r *AutoRebootReconciler) isTriggered(node *corev1.Node) bool {
+	return r.isTriggeredByCondition(node) != nil || r.isTriggeredByLabel(node) ||
+		(r.Config.TriggerTaint != "" && hasTaint(node, r.Config.TriggerTaint))
+}
+
+// isTriggeredByCondition returns the trigger condition if the node reports it as True
+func (r *AutoRebootReconciler) isTriggeredByCondition(node *corev1.Node) *corev1.NodeCondition {
+	if r.Config.TriggerCondition == "" {
+		return nil
+	}
+
+	for i, condition := range node.Status.Conditions {
+		if string(condition.Type) == r.Config.TriggerCondition && condition.Status == corev1.ConditionTrue {
+			return &node.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// isTriggeredByLabel returns true if the node has the trigger label with the configured value
+func (r *AutoRebootReconciler) isTriggeredByLabel(node *corev1.Node) bool {
+	if r.Config.TriggerLabel == "" {
+		return false
+	}
+
+	value, exists := node.Labels[r.Config.TriggerLabel]
+
+	return exists && (r.Config.TriggerLabelValue == "" || value == r.Config.TriggerLabelValue)
+}
+
+// rebootNodeNameFor returns the name of the RebootNode to create for a triggered node and whether the
+// trigger taint must be removed once the reboot succeeds. A condition trigger is identified by its last
+// transition time, so a condition that clears and fires again results in a new reboot. A label trigger
+// fires once until its RebootNode is deleted. A taint trigger is removed by a successful reboot, so it
+// is identified by the number of its reboots of the node that succeeded before; a failed reboot leaves
+// the taint in place without rebooting the node again. Names too long for an object name, e.g. for long
+// node names, are shortened with a hash of the full name.
+func (r *AutoRebootReconciler) rebootNodeNameFor(
+	node *corev1.Node,
+	rebootNodes []janitordgxcnvidiacomv1alpha1.RebootNode,
+) (string, bool) {
+	if condition := r.isTriggeredByCondition(node); condition != nil {
+		return shortenName(fmt.Sprintf("auto-reboot-%s-%d", node.Name, condition.LastTransitionTime.Unix()),
+			validation.DNS1123SubdomainMaxLength), false
+	}
+
+	if r.isTriggeredByLabel(node) {
+		return shortenName("auto-reboot-"+node.Name, validation.DNS1123SubdomainMaxLength), false
+	}
+
+	succeeded := 0
+
+	for i := range rebootNodes {
+		rebootNode := &rebootNodes[i]
+		if rebootNode.Spec.NodeName == node.Name &&
+			rebootNode.Annotations[RemoveTaintAnnotation] == r.Config.TriggerTaint && rebootNode.IsSucceeded() {
+			succeeded++
+		}
+	}
+
+	return shortenName(fmt.Sprintf("auto-reboot-%s-taint-%d", node.Name, succeeded),
+		validation.DNS1123SubdomainMaxLength), true
+}
+
+// isEligible returns true if the node matches the configured node selector and none of the exclusions
+func (r *AutoRebootReconciler) isEligible(node *corev1.Node) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&r.Config.NodeSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid node selector: %w", err)
+	}
+
+	if !selector.Matches(labels.Set(node.Labels)) {
+		return false, nil
+	}
+
+	for _, exclusion := range r.Config.NodeExclusions {
+		exclusionSelector, err := metav1.LabelSelectorAsSelector(&exclusion)
+		if err != nil {
+			return false, fmt.Errorf("invalid node exclusion selector: %w", err)
+		}
+
+		if exclusionSelector.Matches(labels.Set(node.Labels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AutoRebootReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Config.TriggerCondition == "" && r.Config.TriggerLabel == "" && r.Config.TriggerTaint == "" {
+		return errors.New("auto reboot controller requires a trigger condition, label or taint")
+	}
+
+	// Only reconcile nodes that are currently triggered; node status updates are frequent
+	triggered := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		node, ok := obj.(*corev1.Node)
+		if !ok {
+			return false
+		}
+
+		return r.isTriggered(node)
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}).
+		WithEventFilter(triggered).
+		Named("autoreboot").
+		Complete(r)
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+var _ = Describe("AutoReboot Controller", func() {
+	const triggerCondition = "GpuUnhealthy"
+
+	var (
+		ctx        context.Context
+		k8sClient  client.Client
+		reconciler *AutoRebootReconciler
+		testNode   *corev1.Node
+		request    reconcile.Request
+	)
+
+	listRebootNodesFor := func(nodeName string) []janitordgxcnvidiacomv1alpha1.RebootNode {
+		var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+		Expect(k8sClient.List(ctx, &rebootNodes)).To(Succeed())
+
+		var matching []janitordgxcnvidiacomv1alpha1.RebootNode
+
+		for _, rebootNode := range rebootNodes.Items {
+			if rebootNode.Spec.NodeName == nodeName {
+				matching = append(matching, rebootNode)
+			}
+		}
+
+		return matching
+	}
+
+	setTriggerCondition := func(status corev1.ConditionStatus, transition time.Time) {
+		var node corev1.Node
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+		node.Status.Conditions = []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			{
+				Type:               triggerCondition,
+				Status:             status,
+				LastTransitionTime: metav1.NewTime(transition),
+			},
+		}
+		Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+	}
+
+	reconcileNode := func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		testNode = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "auto-node",
+				Labels: map[string]string{"nvidia.com/gpu.present": "true"},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(janitordgxcnvidiacomv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		k8sClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(testNode).
+			WithStatusSubresource(&corev1.Node{}, &janitordgxcnvidiacomv1alpha1.RebootNode{}).
+			Build()
+
+		reconciler = &AutoRebootReconciler{
+			Client: k8sClient,
+			Scheme: scheme,
+			Config: &config.AutoRebootControllerConfig{
+				Enabled:          true,
+				TriggerCondition: triggerCondition,
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: testNode.Name}}
+	})
+
+	Context("when the node does not report the trigger condition", func() {
+		It("should not create a RebootNode", func() {
+			reconcileNode()
+			Expect(listRebootNodesFor(testNode.Name)).To(BeEmpty())
+
+			setTriggerCondition(corev1.ConditionFalse, time.Now())
+			reconcileNode()
+			Expect(listRebootNodesFor(testNode.Name)).To(BeEmpty())
+		})
+	})
+
+	Context("when the node gains the trigger condition", func() {
+		It("should create exactly one RebootNode across repeated reconciles", func() {
+			setTriggerCondition(corev1.ConditionTrue, time.Now())
+
+			for range 5 {
+				reconcileNode()
+			}
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+			Expect(rebootNodes[0].Spec.NodeName).To(Equal(testNode.Name))
+		})
+
+		It("should not create another RebootNode once the first one completes", func() {
+			setTriggerCondition(corev1.ConditionTrue, time.Now())
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+
+			rebootNode := rebootNodes[0]
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			reconcileNode()
+			Expect(listRebootNodesFor(testNode.Name)).To(HaveLen(1))
+		})
+
+		It("should create a new RebootNode when the condition fires again", func() {
+			firstTransition := time.Now().Add(-time.Hour)
+			setTriggerCondition(corev1.ConditionTrue, firstTransition)
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+
+			rebootNode := rebootNodes[0]
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			setTriggerCondition(corev1.ConditionTrue, firstTransition.Add(30*time.Minute))
+			reconcileNode()
+
+			Expect(listRebootNodesFor(testNode.Name)).To(HaveLen(2))
+		})
+
+		It("should not create a RebootNode while another reboot is active", func() {
+			active := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "manual-reboot"},
+				Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: testNode.Name},
+			}
+			Expect(k8sClient.Create(ctx, active)).To(Succeed())
+
+			setTriggerCondition(corev1.ConditionTrue, time.Now())
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+			Expect(rebootNodes[0].Name).To(Equal("manual-reboot"))
+		})
+	})
+
+	Context("when the node name is long", func() {
+		BeforeEach(func() {
+			testNode = &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   strings.Repeat("long-node-name.", 16) + "example",
+					Labels: map[string]string{"nvidia.com/gpu.present": "true"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, testNode)).To(Succeed())
+
+			request = reconcile.Request{NamespacedName: types.NamespacedName{Name: testNode.Name}}
+		})
+
+		It("should shorten the RebootNode names while keeping each firing distinct", func() {
+			firstTransition := time.Now().Add(-time.Hour)
+			setTriggerCondition(corev1.ConditionTrue, firstTransition)
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+			Expect(validation.IsDNS1123Subdomain(rebootNodes[0].Name)).To(BeEmpty())
+
+			rebootNode := rebootNodes[0]
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			setTriggerCondition(corev1.ConditionTrue, firstTransition.Add(30*time.Minute))
+			reconcileNode()
+
+			rebootNodes = listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(2))
+			Expect(rebootNodes[0].Name).NotTo(Equal(rebootNodes[1].Name))
+
+			for _, rebootNode := range rebootNodes {
+				Expect(validation.IsDNS1123Subdomain(rebootNode.Name)).To(BeEmpty())
+			}
+		})
+	})
+
+	Context("when the node does not match the node selector", func() {
+		It("should not create a RebootNode", func() {
+			reconciler.Config.NodeSelector = metav1.LabelSelector{
+				MatchLabels: map[string]string{"nvidia.com/gpu.present": "false"},
+			}
+
+			setTriggerCondition(corev1.ConditionTrue, time.Now())
+			reconcileNode()
+
+			Expect(listRebootNodesFor(testNode.Name)).To(BeEmpty())
+		})
+	})
+
+	Context("when the node is excluded", func() {
+		It("should not create a RebootNode", func() {
+			reconciler.Config.NodeExclusions = []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"nvidia.com/gpu.present": "true"}},
+			}
+
+			setTriggerCondition(corev1.ConditionTrue, time.Now())
+			reconcileNode()
+
+			Expect(listRebootNodesFor(testNode.Name)).To(BeEmpty())
+		})
+	})
+
+	Context("when triggering on a label", func() {
+		BeforeEach(func() {
+			reconciler.Config.TriggerCondition = ""
+			reconciler.Config.TriggerLabel = "nvsentinel.dgxc.nvidia.com/reboot"
+			reconciler.Config.TriggerLabelValue = "requested"
+		})
+
+		It("should create exactly one RebootNode when the label is set", func() {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Labels["nvsentinel.dgxc.nvidia.com/reboot"] = "requested"
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			reconcileNode()
+			reconcileNode()
+
+			Expect(listRebootNodesFor(testNode.Name)).To(HaveLen(1))
+		})
+
+		It("should ignore the label when its value does not match", func() {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Labels["nvsentinel.dgxc.nvidia.com/reboot"] = "skipped"
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			reconcileNode()
+
+			Expect(listRebootNodesFor(testNode.Name)).To(BeEmpty())
+		})
+	})
+
+	Context("when triggering on a taint", func() {
+		const triggerTaint = "nvsentinel.dgxc.nvidia.com/reboot-required"
+
+		BeforeEach(func() {
+			reconciler.Config.TriggerCondition = ""
+			reconciler.Config.TriggerTaint = triggerTaint
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Spec.Taints = []corev1.Taint{{Key: triggerTaint, Effect: corev1.TaintEffectNoSchedule}}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+		})
+
+		It("should create exactly one RebootNode that removes the taint on success", func() {
+			reconcileNode()
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+			Expect(rebootNodes[0].Annotations).To(HaveKeyWithValue(RemoveTaintAnnotation, triggerTaint))
+		})
+
+		It("should create a new RebootNode when the taint is set again after a successful reboot", func() {
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+
+			rebootNode := rebootNodes[0]
+			rebootNode.SetCompletionTime()
+			rebootNode.SetCondition(metav1.Condition{
+				Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+				Status: metav1.ConditionTrue,
+			})
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			reconcileNode()
+			Expect(listRebootNodesFor(testNode.Name)).To(HaveLen(2))
+		})
+
+		It("should not create another RebootNode after a failed reboot", func() {
+			reconcileNode()
+
+			rebootNodes := listRebootNodesFor(testNode.Name)
+			Expect(rebootNodes).To(HaveLen(1))
+
+			rebootNode := rebootNodes[0]
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			reconcileNode()
+			Expect(listRebootNodesFor(testNode.Name)).To(HaveLen(1))
+		})
+	})
+})
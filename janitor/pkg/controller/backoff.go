@@ -14,7 +14,14 @@
 
 package controller
 
-import "time"
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
 
 // getNextRequeueDelay calculates per-resource exponential backoff delay based on consecutive failures.
 // This is used with ctrl.Result{RequeueAfter: delay} rather than the controller's built-in rate limiter
@@ -39,3 +46,54 @@ func getNextRequeueDelay(consecutiveFailures int32) time.Duration {
 
 	return delays[idx]
 }
+
+// remainingBackoff returns how long a RebootNode must still wait before its next attempt, or zero
+// if it can be reconciled now. The next attempt time is persisted in status, so a RebootNode backing
+// off after failures keeps waiting across controller restarts instead of being retried at once. Only
+// a failure backoff is honored; other scheduled requeues are polls that are safe to run early.
+func remainingBackoff(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) time.Duration {
+	next := rebootNode.Status.NextAttemptTime
+	if next == nil || rebootNode.Status.ConsecutiveFailures == 0 {
+		return 0
+	}
+
+	return max(time.Until(next.Time), 0)
+}
+
+// BackoffStep is the requeue delay applied to a resource with a number of consecutive failures
+type BackoffStep struct {
+	ConsecutiveFailures int32
+	Delay               time.Duration
+}
+
+// BackoffSchedule returns the requeue delays for 0 up to, but excluding, the given number of
+// consecutive failures, so operators can preview the backoff without deploying the controller
+func BackoffSchedule(failures int32) []BackoffStep {
+	schedule := make([]BackoffStep, 0, max(failures, 0))
+
+	for i := int32(0); i < failures; i++ {
+		schedule = append(schedule, BackoffStep{
+			ConsecutiveFailures: i,
+			Delay:               getNextRequeueDelay(i),
+		})
+	}
+
+	return schedule
+}
+
+// PrintBackoffSchedule writes the BackoffSchedule for the given number of failures as a table
+func PrintBackoffSchedule(w io.Writer, failures int32) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	if _, err := fmt.Fprintln(tw, "CONSECUTIVE FAILURES\tREQUEUE DELAY"); err != nil {
+		return err
+	}
+
+	for _, step := range BackoffSchedule(failures) {
+		if _, err := fmt.Fprintf(tw, "%d\t%s\n", step.ConsecutiveFailures, step.Delay); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
@@ -15,8 +15,14 @@
 package controller
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 )
 
 func TestGetNextRequeueDelay(t *testing.T) {
@@ -87,3 +93,93 @@ func TestGetNextRequeueDelay_BackoffProgression(t *testing.T) {
 		prevDelay = delay
 	}
 }
+
+func TestBackoffSchedule(t *testing.T) {
+	schedule := BackoffSchedule(6)
+
+	expected := []time.Duration{
+		30 * time.Second,
+		1 * time.Minute,
+		2 * time.Minute,
+		5 * time.Minute,
+		5 * time.Minute,
+		5 * time.Minute,
+	}
+
+	if len(schedule) != len(expected) {
+		t.Fatalf("BackoffSchedule(6) returned %d steps, want %d", len(schedule), len(expected))
+	}
+
+	for i, step := range schedule {
+		if step.ConsecutiveFailures != int32(i) || step.Delay != expected[i] {
+			t.Errorf("step %d = %+v, want %d failures with delay %v", i, step, i, expected[i])
+		}
+	}
+
+	if got := BackoffSchedule(0); len(got) != 0 {
+		t.Errorf("BackoffSchedule(0) = %v, want an empty schedule", got)
+	}
+}
+
+func TestPrintBackoffSchedule(t *testing.T) {
+	var buf bytes.Buffer
+	if err := PrintBackoffSchedule(&buf, 3); err != nil {
+		t.Fatalf("PrintBackoffSchedule() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := [][]string{
+		{"CONSECUTIVE", "FAILURES", "REQUEUE", "DELAY"},
+		{"0", "30s"},
+		{"1", "1m0s"},
+		{"2", "2m0s"},
+	}
+
+	if len(lines) != len(expected) {
+		t.Fatalf("PrintBackoffSchedule() printed %d lines, want %d:\n%s", len(lines), len(expected), buf.String())
+	}
+
+	for i, line := range lines {
+		if fields := strings.Fields(line); strings.Join(fields, " ") != strings.Join(expected[i], " ") {
+			t.Errorf("line %d = %q, want fields %v", i, line, expected[i])
+		}
+	}
+}
+
+func TestRemainingBackoff(t *testing.T) {
+	future := metav1.NewTime(time.Now().Add(2 * time.Minute))
+	past := metav1.NewTime(time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name   string
+		status janitordgxcnvidiacomv1alpha1.RebootNodeStatus
+		want   time.Duration
+	}{
+		{
+			name:   "no next attempt",
+			status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{ConsecutiveFailures: 2},
+		},
+		{
+			name:   "no failures",
+			status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{NextAttemptTime: &future},
+		},
+		{
+			name:   "next attempt passed",
+			status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{ConsecutiveFailures: 2, NextAttemptTime: &past},
+		},
+		{
+			name:   "backing off",
+			status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{ConsecutiveFailures: 2, NextAttemptTime: &future},
+			want:   2 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := remainingBackoff(&janitordgxcnvidiacomv1alpha1.RebootNode{Status: tt.status})
+			if got < tt.want-5*time.Second || got > tt.want {
+				t.Errorf("remainingBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/stringutil"
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+// AllowControlPlaneAnnotation set to true on a RebootNode allows it to reboot a control-plane node
+const AllowControlPlaneAnnotation = "janitor.dgxc.nvidia.com/allow-control-plane"
+
+// controlPlaneRoleLabels mark control-plane nodes; master is the label of older clusters
+var controlPlaneRoleLabels = []string{
+	"node-role.kubernetes.io/control-plane",
+	"node-role.kubernetes.io/master",
+}
+
+// isControlPlaneNode returns true if the node carries a control-plane role label, whatever its value
+func isControlPlaneNode(node *corev1.Node) bool {
+	for _, label := range controlPlaneRoleLabels {
+		if _, exists := node.Labels[label]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shouldRefuseControlPlaneReboot returns true if the node is a control-plane node and neither the
+// configuration nor the RebootNode allows rebooting control-plane nodes
+func (r *RebootNodeReconciler) shouldRefuseControlPlaneReboot(
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) bool {
+	if !isControlPlaneNode(node) {
+		return false
+	}
+
+	if r.Config != nil && r.Config.AllowControlPlane {
+		return false
+	}
+
+	return !stringutil.IsTruthyValue(rebootNode.Annotations[AllowControlPlaneAnnotation])
+}
+
+// refuseControlPlaneReboot fails the RebootNode without sending a reboot signal, since rebooting a
+// control-plane node may take the cluster down. The refusal is terminal.
+func (r *RebootNodeReconciler) refuseControlPlaneReboot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	log.FromContext(ctx).Info("refusing to reboot control-plane node",
+		"node", node.Name,
+		"annotation", AllowControlPlaneAnnotation)
+
+	rebootNode.SetCompletionTime()
+	rebootNode.SetCondition(metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootRefusedControlPlane,
+		Status: metav1.ConditionTrue,
+		Reason: janitordgxcnvidiacomv1alpha1.RebootRefusedControlPlaneReason,
+		Message: fmt.Sprintf("Node is a control-plane node; annotate the RebootNode with %s=true to reboot it",
+			AllowControlPlaneAnnotation),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+	return ctrl.Result{} // Don't requeue, the refusal is terminal
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+// DefaultDeletionTimeout bounds the pending CSP operation cleanup of a deleted RebootNode when no
+// deletion timeout is configured
+const DefaultDeletionTimeout = 30 * time.Second
+
+// cleanupPendingOperation makes sure that no CSP operation started for a deleted RebootNode is left
+// pending on its node, cancelling it when the provider supports it. It is best effort: it only runs
+// for providers that can report pending operations, is bounded by the deletion timeout, and logs
+// failures rather than holding the finalizer.
+func (r *RebootNodeReconciler) cleanupPendingOperation(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	if !isSignalSent(rebootNode) {
+		return
+	}
+
+	checker, ok := model.AsPendingOperationChecker(r.CSPClient)
+	if !ok {
+		return
+	}
+
+	logger := log.FromContext(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, r.getDeletionTimeout())
+	defer cancel()
+
+	var node corev1.Node
+	if err := r.nodeGetter().GetNode(ctx, rebootNode.Spec.NodeName, &node); err != nil {
+		logger.Error(err, "failed to get node to check for a pending CSP operation",
+			"node", rebootNode.Spec.NodeName)
+
+		return
+	}
+
+	pending, err := checker.HasPendingOperation(ctx, node)
+	if err != nil {
+		logger.Error(err, "failed to check for a pending CSP operation",
+			"node", node.Name,
+			"cspRef", rebootNode.GetCSPReqRef())
+
+		return
+	}
+
+	if !pending {
+		return
+	}
+
+	canceller, ok := model.AsOperationCanceller(r.CSPClient)
+	if !ok {
+		logger.Info("CSP operation still pending for deleted rebootnode, provider cannot cancel it",
+			"node", node.Name,
+			"cspRef", rebootNode.GetCSPReqRef())
+
+		return
+	}
+
+	if err := canceller.CancelPendingOperation(ctx, node); err != nil {
+		logger.Error(err, "failed to cancel pending CSP operation",
+			"node", node.Name,
+			"cspRef", rebootNode.GetCSPReqRef())
+
+		return
+	}
+
+	logger.Info("cancelled pending CSP operation of deleted rebootnode",
+		"node", node.Name,
+		"cspRef", rebootNode.GetCSPReqRef())
+}
+
+// getDeletionTimeout returns the timeout of the pending CSP operation cleanup, using the default when
+// none is configured
+func (r *RebootNodeReconciler) getDeletionTimeout() time.Duration {
+	if r.Config == nil || r.Config.DeletionTimeout <= 0 {
+		return DefaultDeletionTimeout
+	}
+
+	return r.Config.DeletionTimeout
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// isNodeReadyUnknown returns true if the node controller lost contact with the node's kubelet
+func isNodeReadyUnknown(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionUnknown
+		}
+	}
+
+	return false
+}
+
+// shouldHoldForDegradedCluster returns true if a timed out reboot should be held instead of failed
+// because the rebooted node's Ready condition is Unknown together with at least the configured share
+// of the other nodes. Widespread Unknown readiness points at the control plane rather than the node.
+// The nodes are listed from the manager cache, so the check does not reach the API server.
+func (r *RebootNodeReconciler) shouldHoldForDegradedCluster(ctx context.Context, node *corev1.Node) bool {
+	if r.Config == nil || r.Config.DegradedCluster.UnknownNodesPercent <= 0 || !isNodeReadyUnknown(node) {
+		return false
+	}
+
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list nodes, not holding the reboot for a degraded cluster",
+			"node", node.Name)
+
+		return false
+	}
+
+	total := 0
+	unknown := 0
+
+	for i := range nodes.Items {
+		if nodes.Items[i].Name == node.Name {
+			continue
+		}
+
+		total++
+
+		if isNodeReadyUnknown(&nodes.Items[i]) {
+			unknown++
+		}
+	}
+
+	return total > 0 && unknown*100 >= total*r.Config.DegradedCluster.UnknownNodesPercent
+}
+
+// holdForDegradedCluster keeps a timed out reboot in progress while the cluster is degraded. The reboot
+// times out as usual on the first check after the other nodes recover without this node. The checks
+// made while holding do not count towards the retry limit, so an outage longer than the retries
+// allow does not fail the reboot either.
+func (r *RebootNodeReconciler) holdForDegradedCluster(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	log.FromContext(ctx).Info("node reboot timed out while the cluster is degraded, holding",
+		"node", node.Name,
+		"unknownNodesPercent", r.Config.DegradedCluster.UnknownNodesPercent)
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status: metav1.ConditionUnknown,
+		Reason: "ClusterDegraded",
+		Message: fmt.Sprintf("Node readiness is Unknown on at least %d%% of the nodes, holding the reboot until the cluster recovers",
+			r.Config.DegradedCluster.UnknownNodesPercent),
+		LastTransitionTime: metav1.Now(),
+	})
+	return requeueUncounted(rebootNode, getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// podNodeNameField is the field used to look up the pods scheduled on a node
+	podNodeNameField = "spec.nodeName"
+
+	// evictionTimeout bounds a single eviction request. An eviction blocked by a PodDisruptionBudget is
+	// rejected with a Retry-After that the client would otherwise wait out for more than a minute.
+	evictionTimeout = 5 * time.Second
+
+	// terminatingPodTimeout bounds the wait for an evicted pod past the end of its grace period. A pod
+	// still present by then is assumed to be stuck, e.g. because the kubelet of the node is down, and
+	// no longer holds up the drain.
+	terminatingPodTimeout = 2 * time.Minute
+)
+
+// cordonNode marks the node unschedulable so that no new pods land on it while it is being terminated
+func cordonNode(ctx context.Context, c client.Client, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = true
+
+	if err := c.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed to cordon node %s: %w", node.Name, err)
+	}
+
+	return nil
+}
+
+// drainNode requests eviction of every evictable pod on the node and returns the number of pods that
+// have not left the node yet: pods whose eviction is still blocked, e.g. by a PodDisruptionBudget, and
+// pods evicted or otherwise being deleted that are still terminating. A terminating pod stops counting
+// terminatingPodTimeout after the end of its grace period.
+func drainNode(ctx context.Context, c client.Client, nodeName string) (int, error) {
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.MatchingFields{podNodeNameField: nodeName}); err != nil {
+		return 0, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	pending := 0
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		if !mustLeaveNode(pod) {
+			continue
+		}
+
+		if pod.DeletionTimestamp != nil {
+			if isStillTerminating(pod) {
+				pending++
+			}
+
+			continue
+		}
+
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+
+		evictCtx, cancel := context.WithTimeout(ctx, evictionTimeout)
+		err := c.SubResource("eviction").Create(evictCtx, pod, eviction)
+
+		cancel()
+
+		switch {
+		case apierrors.IsNotFound(err):
+		case err == nil, apierrors.IsTooManyRequests(err), errors.Is(err, context.DeadlineExceeded):
+			// An evicted pod is only gone once it has terminated, which a later drain observes
+			pending++
+		default:
+			return 0, fmt.Errorf("failed to evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// isStillTerminating returns true if the pod being deleted may still be running, i.e. its grace period
+// ended less than terminatingPodTimeout ago
+func isStillTerminating(pod *corev1.Pod) bool {
+	return time.Now().Before(pod.DeletionTimestamp.Add(terminatingPodTimeout))
+}
+
+// mustLeaveNode returns true if the pod has to be gone before its node can be terminated.
+// DaemonSet and static pods are skipped since they are tied to the node, as are pods that
+// have already finished.
+func mustLeaveNode(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if _, isMirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; isMirror {
+		return false
+	}
+
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" {
+			return false
+		}
+	}
+
+	return true
+}
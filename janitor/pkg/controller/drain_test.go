@@ -0,0 +1,180 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+const drainTestNode = "drain-node"
+
+// newDrainClient returns a fake client with the pods indexed by node name, as the manager indexes them
+func newDrainClient(t *testing.T, pods ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pods...).
+		WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.TerminateNode{}).
+		WithIndex(&corev1.Pod{}, podNodeNameField, func(obj client.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}).
+		Build()
+}
+
+// newDrainPod returns a running pod on the drained node. A finalizer keeps it around once evicted,
+// the way a pod stays until its containers have exited.
+func newDrainPod(name string, deletedAt *metav1.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Finalizers:        []string{"test/terminating"},
+			DeletionTimestamp: deletedAt,
+		},
+		Spec:   corev1.PodSpec{NodeName: drainTestNode},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestDrainNode_WaitsForEvictedPods(t *testing.T) {
+	pod := newDrainPod("workload", nil)
+	c := newDrainClient(t, pod)
+
+	pending, err := drainNode(context.Background(), c, drainTestNode)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending, "the pod evicted by this drain has not exited yet")
+
+	var evicted corev1.Pod
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(pod), &evicted))
+	require.NotNil(t, evicted.DeletionTimestamp)
+
+	pending, err = drainNode(context.Background(), c, drainTestNode)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending, "a pod still terminating keeps the node from being terminated")
+
+	evicted.Finalizers = nil
+	require.NoError(t, c.Update(context.Background(), &evicted))
+
+	pending, err = drainNode(context.Background(), c, drainTestNode)
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+}
+
+func TestDrainNode_StopsWaitingForStuckPods(t *testing.T) {
+	stuckSince := metav1.NewTime(time.Now().Add(-terminatingPodTimeout - time.Minute))
+	c := newDrainClient(t, newDrainPod("stuck", &stuckSince))
+
+	pending, err := drainNode(context.Background(), c, drainTestNode)
+	require.NoError(t, err)
+	assert.Zero(t, pending, "a pod terminating long past its grace period no longer holds up the drain")
+}
+
+func TestDrainNode_SkipsPodsTiedToTheNode(t *testing.T) {
+	daemonSetPod := newDrainPod("daemonset", nil)
+	daemonSetPod.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", UID: "uid",
+	}}
+
+	staticPod := newDrainPod("static", nil)
+	staticPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "mirror"}
+
+	finishedPod := newDrainPod("finished", nil)
+	finishedPod.Status.Phase = corev1.PodSucceeded
+
+	c := newDrainClient(t, daemonSetPod, staticPod, finishedPod)
+
+	pending, err := drainNode(context.Background(), c, drainTestNode)
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+}
+
+// completedDrainCount returns the number of completed drain durations recorded so far
+func completedDrainCount(t *testing.T) uint64 {
+	t.Helper()
+
+	families, err := ctrlmetrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "janitor_drain_duration_seconds" {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			for _, pair := range m.GetLabel() {
+				if pair.GetName() == "outcome" && pair.GetValue() == metrics.DrainOutcomeCompleted {
+					return m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestPrepareNodeForTermination_RecordsDrainOnce(t *testing.T) {
+	ctx := context.Background()
+	start := metav1.NewTime(time.Now().Add(-time.Minute))
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: drainTestNode}}
+	terminateNode := &janitordgxcnvidiacomv1alpha1.TerminateNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-terminatenode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.TerminateNodeSpec{NodeName: drainTestNode},
+		Status:     janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{StartTime: &start},
+	}
+
+	c := newDrainClient(t, node, terminateNode)
+	r := &TerminateNodeReconciler{Client: c}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: terminateNode.Name}}
+	before := completedDrainCount(t)
+
+	// A drain repeated to retry the terminate signal, e.g. after a failed reconcile, records nothing new
+	for range 3 {
+		var current janitordgxcnvidiacomv1alpha1.TerminateNode
+		require.NoError(t, c.Get(ctx, req.NamespacedName, &current))
+
+		original := current.DeepCopy()
+
+		ready, err := r.prepareNodeForTermination(ctx, &current, node)
+		require.NoError(t, err)
+		require.True(t, ready)
+
+		_, err = r.updateTerminateNodeStatus(ctx, req, original, &current, ctrl.Result{})
+		require.NoError(t, err)
+		require.NotNil(t, current.Status.DrainCompletedTime)
+	}
+
+	assert.Equal(t, before+1, completedDrainCount(t))
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// addFinalizer adds the finalizer to obj, retrying on conflict with a fresh Get before each attempt.
+// obj is refreshed in place with the latest state from the API server.
+func addFinalizer(ctx context.Context, c client.Client, obj client.Object, finalizer string) error {
+	return updateFinalizers(ctx, c, obj, func() bool {
+		return controllerutil.AddFinalizer(obj, finalizer)
+	})
+}
+
+// removeFinalizer removes the finalizer from obj, retrying on conflict with a fresh Get before each attempt.
+// An object that has already been deleted is not treated as an error.
+func removeFinalizer(ctx context.Context, c client.Client, obj client.Object, finalizer string) error {
+	err := updateFinalizers(ctx, c, obj, func() bool {
+		return controllerutil.RemoveFinalizer(obj, finalizer)
+	})
+
+	return client.IgnoreNotFound(err)
+}
+
+// updateFinalizers fetches the latest version of obj, applies mutate and updates the object if mutate
+// reports a change. A transient conflict with another writer is retried instead of aborting the reconcile.
+func updateFinalizers(ctx context.Context, c client.Client, obj client.Object, mutate func() bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return err
+		}
+
+		if !mutate() {
+			return nil
+		}
+
+		return c.Update(ctx, obj)
+	})
+}
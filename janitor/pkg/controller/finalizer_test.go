@@ -0,0 +1,165 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+// newConflictingClient returns a fake client whose first n Update calls on RebootNode objects fail with a conflict
+func newConflictingClient(t *testing.T, conflicts int, objs ...client.Object) (client.Client, *int) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	updateCalls := 0
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if _, ok := obj.(*janitordgxcnvidiacomv1alpha1.RebootNode); ok {
+					updateCalls++
+					if updateCalls <= conflicts {
+						return apierrors.NewConflict(schema.GroupResource{Resource: "rebootnodes"}, obj.GetName(),
+							assert.AnError)
+					}
+				}
+
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	return c, &updateCalls
+}
+
+func TestAddFinalizer_RetriesOnConflict(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, updateCalls := newConflictingClient(t, 1, rebootNode)
+
+	err := addFinalizer(context.Background(), c, rebootNode, RebootNodeFinalizer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *updateCalls, "first update conflicts, second succeeds")
+
+	var updated janitordgxcnvidiacomv1alpha1.RebootNode
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &updated))
+	assert.Contains(t, updated.Finalizers, RebootNodeFinalizer)
+}
+
+func TestAddFinalizer_NoUpdateWhenPresent(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "finalized-rebootnode",
+			Finalizers: []string{RebootNodeFinalizer},
+		},
+		Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, updateCalls := newConflictingClient(t, 0, rebootNode)
+
+	require.NoError(t, addFinalizer(context.Background(), c, rebootNode, RebootNodeFinalizer))
+	assert.Equal(t, 0, *updateCalls)
+}
+
+func TestRemoveFinalizer_RetriesOnConflict(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "conflict-rebootnode",
+			Finalizers: []string{RebootNodeFinalizer},
+		},
+		Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, updateCalls := newConflictingClient(t, 2, rebootNode)
+
+	require.NoError(t, removeFinalizer(context.Background(), c, rebootNode, RebootNodeFinalizer))
+	assert.Equal(t, 3, *updateCalls)
+
+	var updated janitordgxcnvidiacomv1alpha1.RebootNode
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &updated))
+	assert.NotContains(t, updated.Finalizers, RebootNodeFinalizer)
+}
+
+func TestRemoveFinalizer_ObjectGone(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "missing-rebootnode",
+			Finalizers: []string{RebootNodeFinalizer},
+		},
+	}
+
+	c, _ := newConflictingClient(t, 0)
+
+	assert.NoError(t, removeFinalizer(context.Background(), c, rebootNode, RebootNodeFinalizer))
+}
+
+func TestRebootNodeReconciler_FinalizerConflictSucceedsOnRetry(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, _ := newConflictingClient(t, 1, node, rebootNode)
+
+	r := &RebootNodeReconciler{
+		Client:    c,
+		Config:    &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute},
+		CSPClient: &mockCSPClient{sendRebootSignalResult: model.ResetSignalRequestRef("ref")},
+	}
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: rebootNode.Name},
+	})
+	require.NoError(t, err)
+
+	var updated janitordgxcnvidiacomv1alpha1.RebootNode
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &updated))
+	assert.Contains(t, updated.Finalizers, RebootNodeFinalizer)
+	assert.True(t, updated.IsRebootInProgress())
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+const (
+	// GPUResourceName is the extended resource advertised by the NVIDIA device plugin
+	GPUResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+	// DriverInstalledLabel is set to true by the labeler once a ready driver pod runs on the node
+	DriverInstalledLabel = "nvsentinel.dgxc.nvidia.com/driver.installed"
+
+	// defaultGPUReadinessTimeout bounds the wait for the GPUs when no timeout is configured
+	defaultGPUReadinessTimeout = 10 * time.Minute
+)
+
+// areGPUsReady returns true if the node advertises allocatable GPUs or the labeler reports the
+// GPU driver as installed
+func areGPUsReady(node *corev1.Node) bool {
+	if gpus, ok := node.Status.Allocatable[GPUResourceName]; ok && !gpus.IsZero() {
+		return true
+	}
+
+	return node.Labels[DriverInstalledLabel] == "true"
+}
+
+// shouldWaitForGPUs returns true if GPU readiness is required and the node GPUs are not available yet
+func (r *RebootNodeReconciler) shouldWaitForGPUs(node *corev1.Node) bool {
+	if r.Config == nil || !r.Config.GPUReadiness.Enabled {
+		return false
+	}
+
+	return !areGPUsReady(node)
+}
+
+// getGPUReadinessTimeout returns the time to wait for the GPUs once the node is Ready
+func (r *RebootNodeReconciler) getGPUReadinessTimeout() time.Duration {
+	if r.Config == nil || r.Config.GPUReadiness.Timeout == 0 {
+		return defaultGPUReadinessTimeout
+	}
+
+	return r.Config.GPUReadiness.Timeout
+}
+
+// waitForGPUs records that a Ready node is waiting for its GPUs and fails the reboot once the GPU
+// readiness timeout, measured from the first time the node was seen Ready without GPUs, elapses.
+// A wait recorded before the current reboot attempt started, e.g. before a hard reboot escalation,
+// starts over. The checks made while waiting do not count towards the retry limit, which would
+// otherwise cut the wait short of the GPU readiness timeout.
+func (r *RebootNodeReconciler) waitForGPUs(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	logger := log.FromContext(ctx)
+	timeout := r.getGPUReadinessTimeout()
+
+	waiting := meta.FindStatusCondition(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady)
+	if waiting == nil || waiting.Status != metav1.ConditionFalse ||
+		waiting.LastTransitionTime.Before(rebootNode.Status.StartTime) {
+		logger.Info("node is ready but its GPUs are not available yet, waiting",
+			"node", node.Name,
+			"timeout", timeout)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WaitingForGPUs",
+			Message:            fmt.Sprintf("Node is ready, waiting up to %s for %s or %s=true", timeout, GPUResourceName, DriverInstalledLabel),
+			LastTransitionTime: metav1.Now(),
+		})
+		return requeueUncounted(rebootNode, getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures))
+	}
+
+	if time.Since(waiting.LastTransitionTime.Time) <= timeout {
+		return requeueUncounted(rebootNode, getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures))
+	}
+
+	logger.Error(nil, "node GPUs did not become available after reboot",
+		"node", node.Name,
+		"timeout", timeout)
+
+	rebootNode.SetCompletionTime()
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Timeout",
+		Message:            fmt.Sprintf("GPUs did not become available within %s of the node becoming ready", timeout),
+		LastTransitionTime: metav1.Now(),
+	})
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             "GPUsNotReady",
+		Message:            "Node returned to ready state but its GPUs did not become available",
+		LastTransitionTime: metav1.Now(),
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+	return ctrl.Result{}
+}
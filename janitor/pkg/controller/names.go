@@ -0,0 +1,38 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// nameHashLength is the number of hex characters of the hash that keeps shortened names unique
+const nameHashLength = 8
+
+// shortenName returns name if it fits in maxLength characters. A longer name is cut and suffixed with
+// a hash of the full name, so that names differing only past the cut, e.g. in a timestamp suffix, stay
+// distinct.
+func shortenName(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	prefix := strings.TrimRight(name[:maxLength-nameHashLength-1], "-.")
+
+	return prefix + "-" + hex.EncodeToString(sum[:])[:nameHashLength]
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+func TestShortenName(t *testing.T) {
+	assert.Equal(t, "short-name", shortenName("short-name", 20))
+
+	long := strings.Repeat("a", 30) + "-1700000000"
+	other := strings.Repeat("a", 30) + "-1700003600"
+
+	shortened := shortenName(long, 20)
+	assert.Len(t, shortened, 20)
+	assert.True(t, strings.HasPrefix(shortened, strings.Repeat("a", 11)+"-"))
+	assert.NotEqual(t, shortened, shortenName(other, 20), "names differing past the cut stay distinct")
+	assert.Equal(t, shortened, shortenName(long, 20), "shortening is deterministic")
+
+	assert.False(t, strings.Contains(shortenName("abcdefghij-.klmnopqrstuvwxyz", 20), "-.-"),
+		"separators left at the cut are trimmed")
+}
+
+func TestHookJobName(t *testing.T) {
+	rebootNodeNamed := func(name string) *janitordgxcnvidiacomv1alpha1.RebootNode {
+		return &janitordgxcnvidiacomv1alpha1.RebootNode{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	assert.Equal(t, "reboot-node-1-pre-reboot", hookJobName(rebootNodeNamed("reboot-node-1"), "pre-reboot"))
+
+	// Auto-reboot RebootNodes of the same long node name only differ in their trailing timestamp
+	prefix := "auto-reboot-" + strings.Repeat("gpu-node", 8)
+	first := hookJobName(rebootNodeNamed(prefix+"-1700000000"), "post-reboot")
+	second := hookJobName(rebootNodeNamed(prefix+"-1700003600"), "post-reboot")
+
+	assert.NotEqual(t, first, second)
+
+	for _, name := range []string{first, second} {
+		assert.LessOrEqual(t, len(name), maxJobNameLength)
+		assert.True(t, strings.HasSuffix(name, "-post-reboot"))
+		assert.Empty(t, validation.IsValidLabelValue(name))
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// DefaultRebootNodeConditionType is the type of the node condition reporting the reboot lifecycle
+// when the configuration does not set one
+const DefaultRebootNodeConditionType = "JanitorReboot"
+
+// Reasons of the node condition reporting the reboot lifecycle. The condition is True while the
+// reboot is in progress and False with the outcome once it is over.
+const (
+	NodeConditionReasonInProgress = "InProgress"
+	NodeConditionReasonCompleted  = "Completed"
+	NodeConditionReasonFailed     = "Failed"
+	NodeConditionReasonAbandoned  = "Abandoned"
+)
+
+// getNodeConditionType returns the type of the node condition reporting the reboot lifecycle
+func (r *RebootNodeReconciler) getNodeConditionType() string {
+	if r.Config.NodeCondition.Type == "" {
+		return DefaultRebootNodeConditionType
+	}
+
+	return r.Config.NodeCondition.Type
+}
+
+// reportRebootOnNode sets the reboot lifecycle node condition when the reboot signal was just sent or the
+// RebootNode just reached a terminal state. Reboots of excluded nodes are never reported.
+func (r *RebootNodeReconciler) reportRebootOnNode(
+	ctx context.Context,
+	original *janitordgxcnvidiacomv1alpha1.RebootNode,
+	updated *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	if r.Config == nil || !r.Config.NodeCondition.Enabled ||
+		meta.IsStatusConditionTrue(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootExcluded) {
+		return
+	}
+
+	signalSent := !meta.IsStatusConditionTrue(original.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent) &&
+		meta.IsStatusConditionTrue(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+	completed := original.Status.CompletionTime == nil && updated.Status.CompletionTime != nil
+
+	switch {
+	case completed && updated.IsSucceeded():
+		r.setRebootNodeCondition(ctx, updated, corev1.ConditionFalse, NodeConditionReasonCompleted,
+			fmt.Sprintf("Node rebooted by RebootNode %s", updated.Name))
+	case completed:
+		r.setRebootNodeCondition(ctx, updated, corev1.ConditionFalse, NodeConditionReasonFailed,
+			fmt.Sprintf("RebootNode %s failed: %s", updated.Name, updated.FailureReason()))
+	case signalSent:
+		r.setRebootNodeCondition(ctx, updated, corev1.ConditionTrue, NodeConditionReasonInProgress,
+			fmt.Sprintf("Node is being rebooted by RebootNode %s", updated.Name))
+	}
+}
+
+// setRebootNodeCondition sets the reboot lifecycle condition on the target node, retrying on conflict.
+// The condition is informational, so failures are logged rather than failing the reconcile.
+func (r *RebootNodeReconciler) setRebootNodeCondition(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	status corev1.ConditionStatus,
+	reason, message string,
+) {
+	conditionType := corev1.NodeConditionType(r.getNodeConditionType())
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var node corev1.Node
+		if err := r.Get(ctx, client.ObjectKey{Name: rebootNode.Spec.NodeName}, &node); err != nil {
+			return err
+		}
+
+		now := metav1.Now()
+		condition := corev1.NodeCondition{
+			Type:               conditionType,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+
+		found := false
+
+		for i := range node.Status.Conditions {
+			if node.Status.Conditions[i].Type != conditionType {
+				continue
+			}
+
+			if node.Status.Conditions[i].Status == status {
+				condition.LastTransitionTime = node.Status.Conditions[i].LastTransitionTime
+			}
+
+			node.Status.Conditions[i] = condition
+			found = true
+		}
+
+		if !found {
+			node.Status.Conditions = append(node.Status.Conditions, condition)
+		}
+
+		return r.Status().Update(ctx, &node)
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.FromContext(ctx).Error(err, "failed to set the reboot node condition",
+			"node", rebootNode.Spec.NodeName,
+			"conditionType", conditionType,
+			"reason", reason)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodeGetter gets the Kubernetes node a node action targets. A missing node is reported with a
+// NotFound API error.
+type NodeGetter interface {
+	GetNode(ctx context.Context, name string, node *corev1.Node) error
+}
+
+// clientNodeGetter gets nodes with a controller-runtime client
+type clientNodeGetter struct {
+	client.Reader
+}
+
+// GetNode gets the node from the client
+func (g clientNodeGetter) GetNode(ctx context.Context, name string, node *corev1.Node) error {
+	return g.Get(ctx, client.ObjectKey{Name: name}, node)
+}
+
+// nodeGetter returns the configured node getter, or one reading the reconciler client
+func (r *RebootNodeReconciler) nodeGetter() NodeGetter {
+	if r.NodeGetter != nil {
+		return r.NodeGetter
+	}
+
+	return clientNodeGetter{Reader: r.Client}
+}
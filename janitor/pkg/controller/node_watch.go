@@ -0,0 +1,120 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// watchNodes adds a watch on nodes to the controller, so that a rebooted node turning Ready
+// reconciles its RebootNode right away instead of on the next poll. When the janitor may not list
+// and watch nodes, the watch is left out with a warning and reboot completion is still detected by
+// the RequeueAfter polling. It returns true if the watch was added.
+func (r *RebootNodeReconciler) watchNodes(ctx context.Context, b *builder.Builder, c client.Client) bool {
+	logger := log.FromContext(ctx)
+
+	allowed, err := nodeWatchAllowed(ctx, c)
+	if err != nil || !allowed {
+		logger.Info("WARNING: node watch unavailable, detecting reboot completion by polling only",
+			"allowed", allowed,
+			"error", err)
+
+		return false
+	}
+
+	b.Watches(&corev1.Node{},
+		handler.EnqueueRequestsFromMapFunc(r.rebootNodesForNode),
+		builder.WithPredicates(nodeRebootProgressed()))
+
+	return true
+}
+
+// nodeWatchAllowed asks the API server whether the janitor may list and watch nodes
+func nodeWatchAllowed(ctx context.Context, c client.Client) (bool, error) {
+	for _, verb := range []string{"list", "watch"} {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: verb, Resource: "nodes"},
+			},
+		}
+
+		if err := c.Create(ctx, review); err != nil {
+			return false, err
+		}
+
+		if !review.Status.Allowed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// nodeRebootProgressed passes the node updates that may complete a reboot: the node turning Ready
+// or reporting a new boot ID. Other node updates, e.g. heartbeats, are left to the polling.
+func nodeRebootProgressed() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, oldOk := e.ObjectOld.(*corev1.Node)
+			newNode, newOk := e.ObjectNew.(*corev1.Node)
+
+			if !oldOk || !newOk {
+				return false
+			}
+
+			return (!isNodeReady(oldNode) && isNodeReady(newNode)) ||
+				oldNode.Status.NodeInfo.BootID != newNode.Status.NodeInfo.BootID
+		},
+	}
+}
+
+// rebootNodesForNode maps a node to the unfinished RebootNodes rebooting it
+func (r *RebootNodeReconciler) rebootNodesForNode(ctx context.Context, node client.Object) []reconcile.Request {
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := r.List(ctx, &rebootNodes); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list rebootnodes for node event",
+			"node", node.GetName())
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for _, rebootNode := range rebootNodes.Items {
+		if rebootNode.Spec.NodeName == node.GetName() && rebootNode.Status.CompletionTime == nil {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: rebootNode.Name},
+			})
+		}
+	}
+
+	return requests
+}
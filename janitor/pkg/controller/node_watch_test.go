@@ -0,0 +1,165 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+// accessReviewClient returns a fake client answering SelfSubjectAccessReviews with allowed, or
+// failing them with err
+func accessReviewClient(t *testing.T, allowed bool, err error, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, authorizationv1.AddToScheme(scheme))
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+
+				review.Status.Allowed = allowed
+
+				return err
+			},
+		}).
+		Build()
+}
+
+func TestNodeWatchAllowed(t *testing.T) {
+	allowed, err := nodeWatchAllowed(context.Background(), accessReviewClient(t, true, nil))
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = nodeWatchAllowed(context.Background(), accessReviewClient(t, false, nil))
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	_, err = nodeWatchAllowed(context.Background(), accessReviewClient(t, true, assert.AnError))
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestNodeRebootProgressed(t *testing.T) {
+	node := func(ready corev1.ConditionStatus, bootID string) *corev1.Node {
+		return &corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+				NodeInfo:   corev1.NodeSystemInfo{BootID: bootID},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		old      *corev1.Node
+		new      *corev1.Node
+		expected bool
+	}{
+		{"node turned ready", node(corev1.ConditionFalse, "boot-1"), node(corev1.ConditionTrue, "boot-1"), true},
+		{"new boot ID", node(corev1.ConditionTrue, "boot-1"), node(corev1.ConditionTrue, "boot-2"), true},
+		{"heartbeat", node(corev1.ConditionTrue, "boot-1"), node(corev1.ConditionTrue, "boot-1"), false},
+		{"node went not ready", node(corev1.ConditionTrue, "boot-1"), node(corev1.ConditionFalse, "boot-1"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nodeRebootProgressed().Update(event.UpdateEvent{
+				ObjectOld: tt.old,
+				ObjectNew: tt.new,
+			}))
+		})
+	}
+}
+
+func TestRebootNodesForNode(t *testing.T) {
+	now := metav1.Now()
+	inProgress := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "in-progress"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "node-1"},
+	}
+	completed := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "completed"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "node-1"},
+		Status:     janitordgxcnvidiacomv1alpha1.RebootNodeStatus{CompletionTime: &now},
+	}
+	otherNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-node"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "node-2"},
+	}
+
+	r := &RebootNodeReconciler{Client: accessReviewClient(t, true, nil, inProgress, completed, otherNode)}
+
+	requests := r.rebootNodesForNode(context.Background(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "in-progress"}}}, requests)
+}
+
+func TestWatchNodes_FallsBackToPolling(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "polled-node"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "polled-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: node.Name},
+	}
+
+	r := &RebootNodeReconciler{
+		Client:    accessReviewClient(t, false, nil, node, rebootNode),
+		Config:    &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute, WatchNodes: true},
+		CSPClient: &mockCSPClient{sendRebootSignalResult: model.ResetSignalRequestRef("ref")},
+	}
+
+	assert.False(t, r.watchNodes(context.Background(), builder.ControllerManagedBy(nil), r.Client))
+
+	// Without the watch, sending the signal and monitoring the reboot both requeue to poll the node
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: rebootNode.Name}}
+
+	for range 2 {
+		result, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+		assert.Positive(t, result.RequeueAfter)
+	}
+}
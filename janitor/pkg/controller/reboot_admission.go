@@ -0,0 +1,170 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+// waitingForRebootSlotReason is the SignalSent reason of a RebootNode waiting for a reboot slot
+const waitingForRebootSlotReason = "WaitingForRebootSlot"
+
+// holdsRebootSlot returns true if the RebootNode started its pre-reboot Job, its reboot signal or its
+// manual mode hand-off and has not completed yet
+func holdsRebootSlot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if rebootNode.Status.CompletionTime != nil {
+		return false
+	}
+
+	conditions := rebootNode.Status.Conditions
+
+	return meta.IsStatusConditionTrue(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent) ||
+		meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob) != nil ||
+		meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.ManualModeConditionType) != nil
+}
+
+// waitsForRebootSlot returns true if the RebootNode competes for a reboot slot
+func waitsForRebootSlot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	return rebootNode.Status.CompletionTime == nil &&
+		rebootNode.DeletionTimestamp.IsZero() &&
+		!isRebootNodePaused(rebootNode) &&
+		!holdsRebootSlot(rebootNode)
+}
+
+// sortByAdmissionOrder sorts RebootNodes waiting for a reboot slot in the order they are admitted:
+// by priority in the configured order, then oldest first
+func sortByAdmissionOrder(rebootNodes []janitordgxcnvidiacomv1alpha1.RebootNode, priorityOrder string) {
+	sort.SliceStable(rebootNodes, func(i, j int) bool {
+		a, b := &rebootNodes[i], &rebootNodes[j]
+
+		if a.Spec.Priority != b.Spec.Priority {
+			if priorityOrder == config.PriorityOrderLowestFirst {
+				return a.Spec.Priority < b.Spec.Priority
+			}
+
+			return a.Spec.Priority > b.Spec.Priority
+		}
+
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+
+		return a.Name < b.Name
+	})
+}
+
+// acquireRebootSlot returns true if the RebootNode may start its reboot, that is the concurrent reboot
+// limit is not reached and no RebootNode ahead of it in admission order takes the free slots. The
+// RebootNodes are listed from the manager cache; if they cannot be listed the reboot waits.
+// A RebootNode admitted after waiting has its reboot timeout restarted, so that the wait does not count
+// against it. The SLA is still measured from creation.
+func (r *RebootNodeReconciler) acquireRebootSlot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) bool {
+	if r.Config == nil || r.Config.Admission.MaxConcurrentReboots <= 0 {
+		return true
+	}
+
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := r.List(ctx, &rebootNodes); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list rebootnodes, waiting for a reboot slot",
+			"node", rebootNode.Spec.NodeName)
+
+		return false
+	}
+
+	freeSlots := r.Config.Admission.MaxConcurrentReboots
+	waiting := []janitordgxcnvidiacomv1alpha1.RebootNode{*rebootNode}
+
+	for i := range rebootNodes.Items {
+		other := &rebootNodes.Items[i]
+		if other.Name == rebootNode.Name {
+			continue
+		}
+
+		if holdsRebootSlot(other) {
+			freeSlots--
+		} else if waitsForRebootSlot(other) {
+			waiting = append(waiting, *other)
+		}
+	}
+
+	if freeSlots <= 0 {
+		return false
+	}
+
+	sortByAdmissionOrder(waiting, r.Config.Admission.PriorityOrder)
+
+	admitted := false
+
+	for i := range min(freeSlots, len(waiting)) {
+		admitted = admitted || waiting[i].Name == rebootNode.Name
+	}
+
+	if !admitted {
+		return false
+	}
+
+	signalSent := meta.FindStatusCondition(rebootNode.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+	if signalSent != nil && signalSent.Reason == waitingForRebootSlotReason {
+		log.FromContext(ctx).Info("reboot slot acquired", "node", rebootNode.Spec.NodeName)
+
+		now := metav1.Now()
+		rebootNode.Status.StartTime = &now
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "RebootSlotAcquired",
+			Message:            "Reboot slot acquired, reboot signal not yet sent",
+			LastTransitionTime: now,
+		})
+	}
+
+	return true
+}
+
+// waitForRebootSlot records that the RebootNode waits for a reboot slot and requeues it
+func (r *RebootNodeReconciler) waitForRebootSlot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) ctrl.Result {
+	log.FromContext(ctx).V(1).Info("concurrent reboot limit reached, waiting for a reboot slot",
+		"node", rebootNode.Spec.NodeName,
+		"priority", rebootNode.Spec.Priority,
+		"maxConcurrentReboots", r.Config.Admission.MaxConcurrentReboots)
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+		Status: metav1.ConditionUnknown,
+		Reason: waitingForRebootSlotReason,
+		Message: fmt.Sprintf("Waiting for one of %d reboot slots with priority %d",
+			r.Config.Admission.MaxConcurrentReboots, rebootNode.Spec.Priority),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return ctrl.Result{RequeueAfter: getNextRequeueDelay(0)}
+}
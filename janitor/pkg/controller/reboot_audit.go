@@ -0,0 +1,70 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/audit"
+)
+
+// auditRebootTransitions writes an audit record for every condition whose status or reason changed in
+// the status just written. A condition that did not exist before transitions from an empty state.
+// Write failures are logged and never fail the reconcile.
+func (r *RebootNodeReconciler) auditRebootTransitions(
+	ctx context.Context,
+	original *janitordgxcnvidiacomv1alpha1.RebootNode,
+	updated *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	if r.Auditor == nil {
+		return
+	}
+
+	for _, condition := range updated.Status.Conditions {
+		fromState := ""
+
+		previous := meta.FindStatusCondition(original.Status.Conditions, condition.Type)
+		if previous != nil {
+			if previous.Status == condition.Status && previous.Reason == condition.Reason {
+				continue
+			}
+
+			fromState = string(previous.Status)
+		}
+
+		record := audit.Record{
+			Timestamp: condition.LastTransitionTime.Time,
+			Resource:  "RebootNode",
+			Name:      updated.Name,
+			Node:      updated.Spec.NodeName,
+			Condition: condition.Type,
+			FromState: fromState,
+			ToState:   string(condition.Status),
+			Reason:    condition.Reason,
+			Message:   condition.Message,
+			Actor:     audit.ActorJanitor,
+		}
+
+		if err := r.Auditor.Log(record); err != nil {
+			log.FromContext(ctx).Error(err, "failed to write audit record",
+				"node", record.Node,
+				"condition", record.Condition)
+		}
+	}
+}
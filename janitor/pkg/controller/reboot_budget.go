@@ -0,0 +1,129 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// spendsRebootBudget returns true if the RebootNode started a reboot, by signal or manual mode hand-off
+func spendsRebootBudget(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	conditions := rebootNode.Status.Conditions
+
+	return meta.IsStatusConditionTrue(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent) ||
+		meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.ManualModeConditionType) != nil
+}
+
+// rebootBudgetWait returns how long the RebootNode must wait for the reboot budget, or zero if it may
+// start its reboot now. The reboots of the window are those of the other RebootNodes that started a
+// reboot with a StartTime within it, listed from the manager cache; if they cannot be listed the reboot
+// waits. A RebootNode admitted after waiting has its StartTime restarted, so that its own reboot counts
+// against the window it actually starts in.
+func (r *RebootNodeReconciler) rebootBudgetWait(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) time.Duration {
+	budget := r.Config.Budget
+	if budget.MaxReboots <= 0 || budget.Window <= 0 {
+		return 0
+	}
+
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := r.List(ctx, &rebootNodes); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list rebootnodes, waiting for the reboot budget",
+			"node", rebootNode.Spec.NodeName)
+
+		return getNextRequeueDelay(0)
+	}
+
+	windowStart := time.Now().Add(-budget.Window)
+	spent := 0
+
+	var oldest time.Time
+
+	for i := range rebootNodes.Items {
+		other := &rebootNodes.Items[i]
+		if other.Name == rebootNode.Name || other.Status.StartTime == nil || !spendsRebootBudget(other) {
+			continue
+		}
+
+		startTime := other.Status.StartTime.Time
+		if startTime.Before(windowStart) {
+			continue
+		}
+
+		spent++
+
+		if oldest.IsZero() || startTime.Before(oldest) {
+			oldest = startTime
+		}
+	}
+
+	if spent >= budget.MaxReboots {
+		// The budget frees up once the oldest reboot of the window leaves it
+		return max(time.Until(oldest.Add(budget.Window)), time.Second)
+	}
+
+	if meta.IsStatusConditionTrue(rebootNode.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted) {
+		log.FromContext(ctx).Info("reboot budget available", "node", rebootNode.Spec.NodeName)
+
+		now := metav1.Now()
+		rebootNode.Status.StartTime = &now
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted,
+			Status:             metav1.ConditionFalse,
+			Reason:             "BudgetAvailable",
+			Message:            fmt.Sprintf("%d of %d reboots started within %s", spent, budget.MaxReboots, budget.Window),
+			LastTransitionTime: now,
+		})
+	}
+
+	return 0
+}
+
+// deferForRebootBudget records that the reboot is deferred until the reboot budget frees up and
+// requeues the RebootNode for then
+func (r *RebootNodeReconciler) deferForRebootBudget(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	wait time.Duration,
+) ctrl.Result {
+	log.FromContext(ctx).Info("reboot budget exhausted, deferring reboot",
+		"node", rebootNode.Spec.NodeName,
+		"maxReboots", r.Config.Budget.MaxReboots,
+		"window", r.Config.Budget.Window,
+		"wait", wait)
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted,
+		Status: metav1.ConditionTrue,
+		Reason: "BudgetExhausted",
+		Message: fmt.Sprintf("%d reboots already started within %s, reboot deferred",
+			r.Config.Budget.MaxReboots, r.Config.Budget.Window),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return ctrl.Result{RequeueAfter: wait}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// DefaultRebootCountAnnotation is the node annotation counting the successful reboots by the janitor
+// when the configuration does not name one
+const DefaultRebootCountAnnotation = "janitor.dgxc.nvidia.com/reboot-count"
+
+// getRebootCountAnnotation returns the node annotation counting the successful reboots
+func (r *RebootNodeReconciler) getRebootCountAnnotation() string {
+	if r.Config == nil || r.Config.RebootCountAnnotation == "" {
+		return DefaultRebootCountAnnotation
+	}
+
+	return r.Config.RebootCountAnnotation
+}
+
+// incrementRebootCount increments the reboot count annotation of the rebooted node with a patch
+// guarded by the node resource version, retrying on conflict. The count outlives the RebootNode, so that chronically unhealthy nodes can be found. A
+// count that cannot be parsed restarts from zero, and failures are logged rather than failing the
+// reconcile since the count is informational.
+func (r *RebootNodeReconciler) incrementRebootCount(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	logger := log.FromContext(ctx)
+	annotation := r.getRebootCountAnnotation()
+
+	var count int
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var node corev1.Node
+		if err := r.Get(ctx, client.ObjectKey{Name: rebootNode.Spec.NodeName}, &node); err != nil {
+			return err
+		}
+
+		count = 0
+
+		if value, exists := node.Annotations[annotation]; exists {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 0 {
+				logger.Info("ignoring invalid reboot count on node",
+					"node", node.Name,
+					"annotation", annotation,
+					"value", value)
+			} else {
+				count = parsed
+			}
+		}
+
+		count++
+
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+
+		node.Annotations[annotation] = strconv.Itoa(count)
+
+		return r.Patch(ctx, &node, patch)
+	})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to increment the reboot count of the node",
+				"node", rebootNode.Spec.NodeName,
+				"annotation", annotation)
+		}
+
+		return
+	}
+
+	logger.V(1).Info("incremented the reboot count of the node",
+		"node", rebootNode.Spec.NodeName,
+		"annotation", annotation,
+		"count", count)
+}
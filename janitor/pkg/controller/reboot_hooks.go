@@ -0,0 +1,371 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+const (
+	// HookJobRebootNodeAnnotation is set on hook Jobs to the name of the RebootNode they run for
+	HookJobRebootNodeAnnotation = "janitor.dgxc.nvidia.com/rebootnode"
+	// HookJobNodeAnnotation is set on hook Jobs to the name of the node being rebooted
+	HookJobNodeAnnotation = "janitor.dgxc.nvidia.com/node"
+
+	// defaultHookJobNamespace is used when neither the job template nor the config set a namespace
+	defaultHookJobNamespace = "default"
+	// defaultHookJobTimeout bounds a hook Job when no timeout is configured
+	defaultHookJobTimeout = 10 * time.Minute
+	// maxJobNameLength keeps hook Job names usable as the job-name label of their pods
+	maxJobNameLength = 63
+)
+
+// rebootHook describes a Job run at a stage of the reboot from a RebootNode job template
+type rebootHook struct {
+	// name suffixes the name of the hook Job
+	name string
+	// conditionType and the reasons report the state of the hook Job on the RebootNode
+	conditionType   string
+	runningReason   string
+	succeededReason string
+	failedReason    string
+}
+
+var (
+	preRebootHook = rebootHook{
+		name:            "pre-reboot",
+		conditionType:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob,
+		runningReason:   janitordgxcnvidiacomv1alpha1.PreRebootJobRunning,
+		succeededReason: janitordgxcnvidiacomv1alpha1.PreRebootJobSucceeded,
+		failedReason:    janitordgxcnvidiacomv1alpha1.PreRebootJobFailed,
+	}
+	postRebootHook = rebootHook{
+		name:            "post-reboot",
+		conditionType:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob,
+		runningReason:   janitordgxcnvidiacomv1alpha1.PostRebootJobRunning,
+		succeededReason: janitordgxcnvidiacomv1alpha1.PostRebootJobSucceeded,
+		failedReason:    janitordgxcnvidiacomv1alpha1.PostRebootJobFailed,
+	}
+)
+
+// hookJobPhase is the observed state of a hook Job
+type hookJobPhase int
+
+const (
+	hookJobRunning hookJobPhase = iota
+	hookJobSucceeded
+	hookJobFailed
+)
+
+// hookJobName returns the name of the hook Job of a RebootNode. A RebootNode name too long for the
+// result to stay a valid label value is shortened with a hash of the full name, so that RebootNodes
+// sharing a long prefix, e.g. auto-reboot RebootNodes of the same node, get distinct Jobs.
+func hookJobName(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, hook string) string {
+	return shortenName(rebootNode.Name, maxJobNameLength-len(hook)-1) + "-" + hook
+}
+
+// getHookJobNamespace returns the namespace hook Jobs run in
+func (r *RebootNodeReconciler) getHookJobNamespace() string {
+	if r.Config != nil && r.Config.Hooks.Namespace != "" {
+		return r.Config.Hooks.Namespace
+	}
+
+	return defaultHookJobNamespace
+}
+
+// hookJobReader returns the reader of hook Jobs. Jobs are read from the API server when a reader is
+// configured, so that the janitor needs neither a Job informer nor permission to list and watch Jobs.
+func (r *RebootNodeReconciler) hookJobReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+
+	return r.Client
+}
+
+// getHookJobTimeout returns how long a hook Job may run before the reboot fails
+func (r *RebootNodeReconciler) getHookJobTimeout() time.Duration {
+	if r.Config == nil || r.Config.Hooks.JobTimeout == 0 {
+		return defaultHookJobTimeout
+	}
+
+	return r.Config.Hooks.JobTimeout
+}
+
+// ensureHookJob creates the hook Job of the RebootNode from the template unless it already exists,
+// and returns its phase along with the failure message of a failed Job. The Job is owned by the
+// RebootNode so it is garbage collected with it. Hook Jobs only run in the configured namespace, so
+// a template setting another one fails, as does an existing Job of the same name owned by something
+// else.
+func (r *RebootNodeReconciler) ensureHookJob(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	template *batchv1.JobTemplateSpec,
+	hook string,
+) (hookJobPhase, string, error) {
+	key := client.ObjectKey{Namespace: r.getHookJobNamespace(), Name: hookJobName(rebootNode, hook)}
+
+	if template.Namespace != "" && template.Namespace != key.Namespace {
+		return hookJobFailed, fmt.Sprintf("%s job template namespace %q is not the hook job namespace %q",
+			hook, template.Namespace, key.Namespace), nil
+	}
+
+	var job batchv1.Job
+
+	err := r.hookJobReader().Get(ctx, key, &job)
+	if apierrors.IsNotFound(err) {
+		job = batchv1.Job{
+			ObjectMeta: *template.ObjectMeta.DeepCopy(),
+			Spec:       *template.Spec.DeepCopy(),
+		}
+		job.Name = key.Name
+		job.Namespace = key.Namespace
+
+		if job.Annotations == nil {
+			job.Annotations = make(map[string]string)
+		}
+
+		job.Annotations[HookJobRebootNodeAnnotation] = rebootNode.Name
+		job.Annotations[HookJobNodeAnnotation] = rebootNode.Spec.NodeName
+
+		if err := controllerutil.SetControllerReference(rebootNode, &job, r.Scheme); err != nil {
+			return hookJobRunning, "", fmt.Errorf("failed to set owner of %s job: %w", hook, err)
+		}
+
+		if err := r.Create(ctx, &job); err != nil {
+			return hookJobRunning, "", fmt.Errorf("failed to create %s job: %w", hook, err)
+		}
+
+		return hookJobRunning, "", nil
+	}
+
+	if err != nil {
+		return hookJobRunning, "", fmt.Errorf("failed to get %s job: %w", hook, err)
+	}
+
+	if !metav1.IsControlledBy(&job, rebootNode) {
+		return hookJobFailed, fmt.Sprintf("%s job %s/%s exists but is not owned by RebootNode %s",
+			hook, key.Namespace, key.Name, rebootNode.Name), nil
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return hookJobSucceeded, "", nil
+		case batchv1.JobFailed:
+			return hookJobFailed, fmt.Sprintf("%s job %s/%s failed: %s", hook, key.Namespace, key.Name, condition.Message), nil
+		}
+	}
+
+	return hookJobRunning, "", nil
+}
+
+// deleteHookJob deletes a hook Job of the RebootNode that is no longer waited for, along with its
+// pods. A Job of the same name owned by something else is left alone.
+func (r *RebootNodeReconciler) deleteHookJob(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	hook string,
+) {
+	job := &batchv1.Job{}
+	key := client.ObjectKey{Namespace: r.getHookJobNamespace(), Name: hookJobName(rebootNode, hook)}
+
+	err := r.hookJobReader().Get(ctx, key, job)
+	if err == nil {
+		if !metav1.IsControlledBy(job, rebootNode) {
+			return
+		}
+
+		err = r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground),
+			client.Preconditions{UID: &job.UID})
+	}
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.FromContext(ctx).Error(err, "failed to delete hook job",
+			"job", job.Name,
+			"namespace", job.Namespace)
+	}
+}
+
+// runHookJob runs the hook Job of the RebootNode and records its state in the hook condition. It
+// returns true once the Job succeeded. A Job that fails, or runs longer than the hook job timeout
+// measured from its creation, completes the RebootNode as failed and returns the failure; the
+// caller records what that means for the reboot. Otherwise the returned result polls the Job;
+// polling a running Job does not count towards the retry limit since the timeout bounds it.
+func (r *RebootNodeReconciler) runHookJob(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+	template *batchv1.JobTemplateSpec,
+	hook rebootHook,
+) (bool, string, ctrl.Result) {
+	logger := log.FromContext(ctx)
+	timeout := r.getHookJobTimeout()
+
+	phase, failure, err := r.ensureHookJob(ctx, rebootNode, template, hook.name)
+	if err != nil {
+		logger.Error(err, "hook job could not be run, will retry", "node", node.Name, "hook", hook.name)
+
+		rebootNode.Status.ConsecutiveFailures++
+
+		return false, "", ctrl.Result{RequeueAfter: getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures)}
+	}
+
+	running := meta.FindStatusCondition(rebootNode.Status.Conditions, hook.conditionType)
+
+	switch {
+	case phase == hookJobSucceeded:
+		logger.Info("hook job succeeded", "node", node.Name, "hook", hook.name)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               hook.conditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             hook.succeededReason,
+			Message:            fmt.Sprintf("Job %s completed", hookJobName(rebootNode, hook.name)),
+			LastTransitionTime: metav1.Now(),
+		})
+
+		return true, "", ctrl.Result{}
+	case phase == hookJobRunning && running == nil:
+		logger.Info("hook job started, waiting for it",
+			"node", node.Name,
+			"hook", hook.name,
+			"timeout", timeout)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               hook.conditionType,
+			Status:             metav1.ConditionUnknown,
+			Reason:             hook.runningReason,
+			Message:            fmt.Sprintf("Waiting up to %s for job %s", timeout, hookJobName(rebootNode, hook.name)),
+			LastTransitionTime: metav1.Now(),
+		})
+		return false, "", requeueUncounted(rebootNode, getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures))
+	case phase == hookJobRunning && time.Since(running.LastTransitionTime.Time) <= timeout:
+		return false, "", requeueUncounted(rebootNode, getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures))
+	case phase == hookJobRunning:
+		r.deleteHookJob(ctx, rebootNode, hook.name)
+
+		failure = fmt.Sprintf("%s job did not complete within %s", hook.name, timeout)
+	}
+
+	logger.Error(nil, "hook job failed",
+		"node", node.Name,
+		"hook", hook.name,
+		"reason", failure)
+
+	rebootNode.SetCompletionTime()
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               hook.conditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             hook.failedReason,
+		Message:            failure,
+		LastTransitionTime: metav1.Now(),
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+	return false, failure, ctrl.Result{}
+}
+
+// shouldRunPreRebootJob returns true if the RebootNode has a pre-reboot job template whose Job has
+// not succeeded yet. Manual mode skips it since janitor does not send the reboot signal then.
+func (r *RebootNodeReconciler) shouldRunPreRebootJob(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if rebootNode.Spec.PreRebootJobTemplate == nil || (r.Config != nil && r.Config.ManualMode) {
+		return false
+	}
+
+	return !meta.IsStatusConditionTrue(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+}
+
+// runPreRebootJob runs the pre-reboot hook Job and waits for it to succeed before the reboot signal
+// is sent. If the Job fails the node is not rebooted.
+func (r *RebootNodeReconciler) runPreRebootJob(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	succeeded, failure, result := r.runHookJob(ctx, rebootNode, node, rebootNode.Spec.PreRebootJobTemplate, preRebootHook)
+	if succeeded {
+		// Send the reboot signal on the next reconcile
+		return ctrl.Result{RequeueAfter: time.Second}
+	}
+
+	if failure != "" {
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+			Status:             metav1.ConditionFalse,
+			Reason:             janitordgxcnvidiacomv1alpha1.PreRebootJobFailed,
+			Message:            "Reboot signal not sent because the pre-reboot job failed",
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	return result
+}
+
+// shouldRunPostRebootJob returns true if the RebootNode has a post-reboot job template whose Job has
+// not succeeded yet
+func (r *RebootNodeReconciler) shouldRunPostRebootJob(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if rebootNode.Spec.PostRebootJobTemplate == nil {
+		return false
+	}
+
+	return !meta.IsStatusConditionTrue(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob)
+}
+
+// runPostRebootJob runs the post-reboot verification Job once the node is back and requires it to
+// succeed before the reboot is declared successful. If the Job fails the reboot fails.
+func (r *RebootNodeReconciler) runPostRebootJob(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	succeeded, failure, result := r.runHookJob(ctx, rebootNode, node, rebootNode.Spec.PostRebootJobTemplate, postRebootHook)
+	if succeeded {
+		// Declare the reboot successful on the next reconcile
+		return ctrl.Result{RequeueAfter: time.Second}
+	}
+
+	if failure != "" {
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             janitordgxcnvidiacomv1alpha1.VerificationJobFailed,
+			Message:            "Node returned to ready state but the post-reboot verification job failed",
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/notification"
+)
+
+// notifyRebootOutcome hands the outcome of a RebootNode that just reached a terminal state to the
+// configured notifier. Delivery failures are logged and never fail the reconcile.
+func (r *RebootNodeReconciler) notifyRebootOutcome(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	if r.Notifier == nil {
+		return
+	}
+
+	outcome := rebootOutcomeFor(rebootNode)
+
+	if err := r.Notifier.Notify(ctx, outcome); err != nil {
+		log.FromContext(ctx).Error(err, "failed to notify reboot outcome",
+			"node", outcome.Node,
+			"outcome", outcome.Outcome)
+	}
+}
+
+// rebootOutcomeFor summarizes a completed RebootNode from the conditions set by its terminal branch
+func rebootOutcomeFor(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) notification.RebootOutcome {
+	outcome := notification.RebootOutcome{
+		RebootNode: rebootNode.Name,
+		Node:       rebootNode.Spec.NodeName,
+		Outcome:    notification.OutcomeFailed,
+		Attempts:   rebootNode.Status.RetryCount,
+	}
+
+	if completion := rebootNode.Status.CompletionTime; completion != nil {
+		outcome.CompletionTime = completion.Time
+
+		if start := rebootNode.Status.StartTime; start != nil {
+			outcome.DurationSeconds = completion.Sub(start.Time).Seconds()
+		}
+	}
+
+	conditions := rebootNode.Status.Conditions
+	excluded := meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootExcluded)
+	refused := meta.FindStatusCondition(conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootRefusedControlPlane)
+	signalSent := meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+	nodeReady := meta.FindStatusCondition(conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+
+	switch {
+	case excluded != nil && excluded.Status == metav1.ConditionTrue:
+		outcome.Outcome = notification.OutcomeExcluded
+		outcome.Reason = excluded.Message
+	case refused != nil && refused.Status == metav1.ConditionTrue:
+		outcome.Reason = refused.Message
+	case signalSent != nil && signalSent.Status == metav1.ConditionFalse:
+		outcome.Reason = signalSent.Message
+	case nodeReady != nil:
+		outcome.Reason = nodeReady.Message
+
+		switch {
+		case nodeReady.Status == metav1.ConditionTrue:
+			outcome.Outcome = notification.OutcomeSucceeded
+		case nodeReady.Reason == notification.OutcomeTimeout:
+			outcome.Outcome = notification.OutcomeTimeout
+		case nodeReady.Reason == notification.OutcomeMaxRetriesExceeded:
+			outcome.Outcome = notification.OutcomeMaxRetriesExceeded
+		}
+	}
+
+	return outcome
+}
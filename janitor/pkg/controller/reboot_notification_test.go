@@ -0,0 +1,174 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/notification"
+)
+
+func TestRebootNodeReconciler_NotifiesTerminalOutcomes(t *testing.T) {
+	tests := []struct {
+		name             string
+		retryCount       int32
+		startedAgo       time.Duration
+		csp              *mockCSPClient
+		expectedOutcome  string
+		expectedReason   string
+		expectedAttempts int32
+	}{
+		{
+			name:             "success",
+			startedAgo:       5 * time.Minute,
+			csp:              &mockCSPClient{isNodeReadyResult: true},
+			expectedOutcome:  notification.OutcomeSucceeded,
+			expectedReason:   "Node reached ready state post-reboot",
+			expectedAttempts: 1,
+		},
+		{
+			name:            "failure",
+			startedAgo:      5 * time.Minute,
+			csp:             &mockCSPClient{isNodeReadyError: errors.New("csp unavailable")},
+			expectedOutcome: notification.OutcomeFailed,
+			expectedReason: "Node status could not be checked from CSP: " +
+				"IsNodeReady failed for node test-node (request test-request-ref): csp unavailable",
+			expectedAttempts: 1,
+		},
+		{
+			name:             "timeout",
+			startedAgo:       time.Hour,
+			csp:              &mockCSPClient{},
+			expectedOutcome:  notification.OutcomeTimeout,
+			expectedReason:   "Node failed to return to ready state after timeout duration",
+			expectedAttempts: 1,
+		},
+		{
+			name:             "max retries exceeded",
+			retryCount:       MaxRebootRetries,
+			startedAgo:       5 * time.Minute,
+			csp:              &mockCSPClient{},
+			expectedOutcome:  notification.OutcomeMaxRetriesExceeded,
+			expectedReason:   "Node failed to reach ready state after 20 retries over 30m0s",
+			expectedAttempts: MaxRebootRetries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan notification.RebootOutcome, 1)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var outcome notification.RebootOutcome
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&outcome))
+
+				received <- outcome
+			}))
+			defer server.Close()
+
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+
+			startTime := metav1.NewTime(time.Now().Add(-tt.startedAgo))
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-rebootnode",
+					Finalizers: []string{RebootNodeFinalizer},
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: node.Name},
+				Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+					StartTime:  &startTime,
+					RetryCount: tt.retryCount,
+					Conditions: []metav1.Condition{
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Succeeded",
+							Message:            "test-request-ref",
+							LastTransitionTime: startTime,
+						},
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Initializing",
+							LastTransitionTime: startTime,
+						},
+					},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+			r := &RebootNodeReconciler{
+				Client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(node, rebootNode).
+					WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+					Build(),
+				Config:    &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute},
+				CSPClient: tt.csp,
+				Notifier:  notification.NewWebhookSink(server.URL, time.Second),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: rebootNode.Name}}
+
+			_, err := r.Reconcile(context.Background(), request)
+			require.NoError(t, err)
+
+			var outcome notification.RebootOutcome
+			select {
+			case outcome = <-received:
+			case <-time.After(5 * time.Second):
+				t.Fatal("webhook did not receive the reboot outcome")
+			}
+
+			assert.Equal(t, rebootNode.Name, outcome.RebootNode)
+			assert.Equal(t, node.Name, outcome.Node)
+			assert.Equal(t, tt.expectedOutcome, outcome.Outcome)
+			assert.Equal(t, tt.expectedReason, outcome.Reason)
+			assert.Equal(t, tt.expectedAttempts, outcome.Attempts)
+			assert.InDelta(t, tt.startedAgo.Seconds(), outcome.DurationSeconds, 5)
+			assert.False(t, outcome.CompletionTime.IsZero())
+
+			// A completed RebootNode is never reported again
+			_, err = r.Reconcile(context.Background(), request)
+			require.NoError(t, err)
+			assert.Empty(t, received)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+// KubeletStartTimeAnnotation on a node holds the RFC 3339 time its kubelet started, for node agents
+// that publish it. Without it, the time the node last became Ready stands in for the kubelet start.
+const KubeletStartTimeAnnotation = "janitor.dgxc.nvidia.com/kubelet-start-time"
+
+// rebootVerifier proves from the Kubernetes node that a reboot happened since its signal was sent
+type rebootVerifier interface {
+	// recordPreReboot captures the node state the reboot is later verified against
+	recordPreReboot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node)
+	// rebootObserved returns true if the node state proves the reboot happened
+	rebootObserved(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) bool
+}
+
+// rebootVerifiers holds the verifier of every verification strategy
+var rebootVerifiers = map[string]rebootVerifier{
+	config.VerificationStrategyBootID:           bootIDVerifier{},
+	config.VerificationStrategyKubeletStartTime: kubeletStartTimeVerifier{},
+	config.VerificationStrategyNone:             noneVerifier{},
+}
+
+// rebootVerifier returns the verifier of the configured verification strategy, or the boot ID
+// verifier when none is configured, which keeps the check of earlier releases rather than trusting
+// readiness alone
+func (r *RebootNodeReconciler) rebootVerifier() rebootVerifier {
+	if verifier, ok := rebootVerifiers[r.Config.VerificationStrategy]; ok {
+		return verifier
+	}
+
+	return rebootVerifiers[config.VerificationStrategyBootID]
+}
+
+// noneVerifier trusts the readiness reports, treating every ready node as rebooted
+type noneVerifier struct{}
+
+func (noneVerifier) recordPreReboot(*janitordgxcnvidiacomv1alpha1.RebootNode, *corev1.Node) {}
+
+func (noneVerifier) rebootObserved(*janitordgxcnvidiacomv1alpha1.RebootNode, *corev1.Node) bool {
+	return true
+}
+
+// bootIDVerifier requires a boot ID change of a node that was already NotReady before the signal,
+// since readiness cannot tell the original outage recovering from the node coming back after a reboot
+type bootIDVerifier struct{}
+
+func (bootIDVerifier) recordPreReboot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) {
+	rebootNode.RecordPreRebootState(isNodeReady(node), node.Status.NodeInfo.BootID)
+}
+
+func (bootIDVerifier) rebootObserved(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) bool {
+	return rebootNode.IsRebootObserved(node.Status.NodeInfo.BootID)
+}
+
+// kubeletStartTimeVerifier requires the kubelet start time to advance past the one recorded when the
+// signal was sent, for environments where the boot ID is not reported
+type kubeletStartTimeVerifier struct{}
+
+func (kubeletStartTimeVerifier) recordPreReboot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) {
+	rebootNode.RecordPreRebootState(isNodeReady(node), node.Status.NodeInfo.BootID)
+
+	// A node without a known start time must start after the signal
+	startTime := kubeletStartTime(node)
+	if startTime == nil {
+		now := metav1.Now()
+		startTime = &now
+	}
+
+	rebootNode.Status.PreRebootKubeletStartTime = startTime
+}
+
+func (kubeletStartTimeVerifier) rebootObserved(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) bool {
+	before := rebootNode.Status.PreRebootKubeletStartTime
+	if before == nil {
+		return true
+	}
+
+	startTime := kubeletStartTime(node)
+
+	return startTime != nil && startTime.After(before.Time)
+}
+
+// kubeletStartTime returns the time the node kubelet started, taken from KubeletStartTimeAnnotation
+// or else from the time the node last became Ready, or nil if it is not known
+func kubeletStartTime(node *corev1.Node) *metav1.Time {
+	if value, ok := node.Annotations[KubeletStartTimeAnnotation]; ok {
+		if startTime, err := time.Parse(time.RFC3339, value); err == nil {
+			return &metav1.Time{Time: startTime}
+		}
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+			return condition.LastTransitionTime.DeepCopy()
+		}
+	}
+
+	return nil
+}
+
+// failUnobservedReboot fails a timed out reboot of a node that is ready but was never proven to have
+// rebooted, distinguishing a node that never went down from one that did not come back
+func (r *RebootNodeReconciler) failUnobservedReboot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	log.FromContext(ctx).Error(nil, "node is ready but was not observed to reboot before the timeout",
+		"node", node.Name,
+		"verification", r.Config.VerificationStrategy,
+		"elapsed", time.Since(rebootNode.Status.StartTime.Time))
+
+	rebootNode.SetCompletionTime()
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             janitordgxcnvidiacomv1alpha1.RebootNotObserved,
+		Message:            "Node is ready but showed no sign of having rebooted before the timeout duration",
+		LastTransitionTime: metav1.Now(),
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+	return ctrl.Result{}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+func TestRebootVerifier_SelectedByConfig(t *testing.T) {
+	readySince := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	// The node was NotReady when the signal was sent and is Ready again, with neither its boot ID
+	// nor its kubelet start time changed since
+	notReady := corev1.Node{Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{BootID: "boot-1"}}}
+	recovered := corev1.Node{Status: corev1.NodeStatus{
+		NodeInfo:   corev1.NodeSystemInfo{BootID: "boot-1"},
+		Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastTransitionTime: readySince}},
+	}}
+
+	tests := []struct {
+		strategy string
+		want     rebootVerifier
+		observed bool
+	}{
+		{strategy: "", want: bootIDVerifier{}, observed: false},
+		{strategy: config.VerificationStrategyBootID, want: bootIDVerifier{}, observed: false},
+		{strategy: config.VerificationStrategyKubeletStartTime, want: kubeletStartTimeVerifier{}, observed: false},
+		{strategy: config.VerificationStrategyNone, want: noneVerifier{}, observed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run("strategy "+tt.strategy, func(t *testing.T) {
+			r := &RebootNodeReconciler{Config: &config.RebootNodeControllerConfig{VerificationStrategy: tt.strategy}}
+
+			verifier := r.rebootVerifier()
+			if verifier != tt.want {
+				t.Fatalf("rebootVerifier() = %T, want %T", verifier, tt.want)
+			}
+
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{}
+			verifier.recordPreReboot(rebootNode, &notReady)
+
+			if got := verifier.rebootObserved(rebootNode, &recovered); got != tt.observed {
+				t.Errorf("rebootObserved() = %v, want %v", got, tt.observed)
+			}
+		})
+	}
+}
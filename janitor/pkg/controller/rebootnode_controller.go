@@ -19,36 +19,68 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/stringutil"
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/audit"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/csp"
 	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
 	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+	"github.com/nvidia/nvsentinel/janitor/pkg/notification"
 )
 
 const (
-	// RebootNodeFinalizer is added to RebootNode objects to handle cleanup
+	// RebootNodeFinalizer is the default finalizer added to RebootNode objects to handle cleanup
 	RebootNodeFinalizer = "janitor.dgxc.nvidia.com/rebootnode-finalizer"
 
 	// CSPOperationTimeout is the maximum time allowed for a single CSP operation
 	CSPOperationTimeout = 2 * time.Minute
 
+	// DefaultFirstCheckDelay is how long after a reboot signal is sent the node is first checked
+	DefaultFirstCheckDelay = 30 * time.Second
+
 	// MaxRebootRetries is the maximum number of retry attempts before giving up
 	MaxRebootRetries = 20 // 10 minutes at 30s base intervals
+
+	// RebootTimeoutAnnotation on the target node overrides the controller reboot timeout for that node
+	RebootTimeoutAnnotation = "janitor.dgxc.nvidia.com/reboot-timeout"
+
+	// MaxRetriesAnnotation on the target node overrides MaxRebootRetries for that node
+	MaxRetriesAnnotation = "janitor.dgxc.nvidia.com/max-retries"
+
+	// RebootExcludeAnnotation set to true on the target node prevents janitor from ever rebooting it
+	RebootExcludeAnnotation = "janitor.dgxc.nvidia.com/reboot-exclude"
+
+	// PauseAnnotation set to true on a RebootNode freezes it until the annotation is removed
+	PauseAnnotation = "janitor.dgxc.nvidia.com/pause"
+
+	// RemoveTaintAnnotation on a RebootNode names a taint key that is removed from the node before the
+	// reboot succeeds, e.g. the taint a health system applied to request the reboot
+	RemoveTaintAnnotation = "janitor.dgxc.nvidia.com/remove-taint-on-success"
+
+	// NeedsAttentionAnnotation is set to true on a node whose reboot exceeded its maximum number of
+	// retries, for humans and alerting to find; the janitor never removes it
+	NeedsAttentionAnnotation = "janitor.dgxc.nvidia.com/reboot-needs-attention"
 )
 
 // updateRebootNodeStatus is a helper function that handles status updates with proper error handling.
-// It delegates to the generic updateNodeActionStatus function.
+// It records the next scheduled attempt derived from the result, delegates to the generic
+// updateNodeActionStatus function, records the SLA, counts successful reboots on the node and
+// notifies the outcome once the RebootNode reaches a terminal state, and reports the reboot lifecycle on the node.
 func (r *RebootNodeReconciler) updateRebootNodeStatus(
 	ctx context.Context,
 	req ctrl.Request,
@@ -56,9 +88,17 @@ func (r *RebootNodeReconciler) updateRebootNodeStatus(
 	updated *janitordgxcnvidiacomv1alpha1.RebootNode,
 	result ctrl.Result,
 ) (ctrl.Result, error) {
-	return updateNodeActionStatus(
+	updated.SetNextAttemptTime(result.RequeueAfter)
+
+	completed := original.Status.CompletionTime == nil && updated.Status.CompletionTime != nil
+	if completed {
+		updated.Status.SLABreached = r.isSLABreached(updated)
+	}
+
+	result, err := updateNodeActionStatus(
 		ctx,
-		r.Status(),
+		r.Client,
+		r.statusWriter(),
 		original,
 		updated,
 		&original.Status,
@@ -67,6 +107,53 @@ func (r *RebootNodeReconciler) updateRebootNodeStatus(
 		"rebootnode",
 		result,
 	)
+	if err == nil && completed {
+		if updated.Status.SLABreached {
+			metrics.GlobalMetrics.IncRebootSLABreach()
+		}
+
+		if updated.IsSucceeded() {
+			r.incrementRebootCount(ctx, updated)
+		}
+
+		if updated.FailureReason() == notification.OutcomeMaxRetriesExceeded {
+			r.flagRetriesExhausted(ctx, updated)
+		}
+
+		recordRebootPhaseDurations(updated)
+
+		r.notifyRebootOutcome(ctx, updated)
+	}
+
+	if err == nil {
+		r.reportRebootOnNode(ctx, original, updated)
+		r.auditRebootTransitions(ctx, original, updated)
+	}
+
+	return result, err
+}
+
+// finishReconcile updates the RebootNode status and returns the outcome of the reconcile along with
+// its result
+func (r *RebootNodeReconciler) finishReconcile(
+	ctx context.Context,
+	req ctrl.Request,
+	original *janitordgxcnvidiacomv1alpha1.RebootNode,
+	updated *janitordgxcnvidiacomv1alpha1.RebootNode,
+	result ctrl.Result,
+) (ctrl.Result, string, error) {
+	result, err := r.updateRebootNodeStatus(ctx, req, original, updated, result)
+
+	return result, reconcileOutcome(original, updated, result, err), err
+}
+
+// statusWriter returns the writer of RebootNode statuses, applying them server-side when configured
+func (r *RebootNodeReconciler) statusWriter() client.SubResourceWriter {
+	if r.Config != nil && r.Config.ServerSideApplyStatus {
+		return applyStatusWriter{SubResourceWriter: r.Status(), scheme: r.Client.Scheme()}
+	}
+
+	return r.Status()
 }
 
 // RebootNodeReconciler reconciles a RebootNode object
@@ -75,51 +162,100 @@ type RebootNodeReconciler struct {
 	Scheme    *runtime.Scheme
 	Config    *config.RebootNodeControllerConfig
 	CSPClient model.CSPClient
+	// Notifier receives the outcome of every RebootNode that reaches a terminal state; optional
+	Notifier notification.NotificationSink
+	// Auditor records every RebootNode condition transition; optional
+	Auditor *audit.Logger
+	// NodeGetter gets the node to reboot; defaults to reading it with the client
+	NodeGetter NodeGetter
+	// APIReader reads hook Jobs from the API server; defaults to reading them with the client
+	APIReader client.Reader
+	// Recorder emits Events on RebootNodes that need attention; optional
+	Recorder record.EventRecorder
+
+	// cspProvider names the CSP in errors returned by CSPClient calls
+	cspProvider string
+	// liveness tracks completed reconciles when a liveness window is configured
+	liveness *reconcileLiveness
 }
 
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=rebootnodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=rebootnodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=rebootnodes/finalizers,verbs=update
-// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=core,resources=nodes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
+// The duration, result and outcome of every reconcile are recorded as metrics, and its completion
+// feeds the reconcile liveness check.
 func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, outcome, err := r.reconcile(ctx, req)
+
+	if err != nil {
+		outcome = metrics.ReconcileOutcomeFailed
+	}
+
+	metrics.GlobalMetrics.RecordReconcileDuration(metrics.ActionTypeReboot, reconcileResult(result, err), time.Since(start))
+	metrics.GlobalMetrics.IncReconcileOutcome(outcome)
+
+	if r.liveness != nil {
+		r.liveness.observe()
+	}
+
+	return result, err
+}
+
+// reconcile performs a single reconciliation of the RebootNode object and returns its outcome, see
+// reconcileOutcome
+func (r *RebootNodeReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, string, error) {
 	logger := log.FromContext(ctx)
 
 	// Get the RebootNode object
 	var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
 	if err := r.Get(ctx, req.NamespacedName, &rebootNode); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return ctrl.Result{}, metrics.ReconcileOutcomeNoop, client.IgnoreNotFound(err)
 	}
 
 	// Handle deletion with finalizer
 	if !rebootNode.DeletionTimestamp.IsZero() {
-		if controllerutil.ContainsFinalizer(&rebootNode, RebootNodeFinalizer) {
+		if controllerutil.ContainsFinalizer(&rebootNode, r.getFinalizerName()) {
 			logger.Info("rebootnode deletion requested, performing cleanup",
 				"node", rebootNode.Spec.NodeName,
 				"conditions", rebootNode.Status.Conditions,
 				"cspRef", rebootNode.GetCSPReqRef())
 
-			// Best effort: log the state for audit trail
-			// Future enhancement: Could add CSP cancellation API call here if available
+			// Best effort: log the state for audit trail and leave no CSP operation pending
+			r.cleanupPendingOperation(ctx, &rebootNode)
+
+			if err := removeFinalizer(ctx, r.Client, &rebootNode, r.getFinalizerName()); err != nil {
+				return ctrl.Result{}, metrics.ReconcileOutcomeFailed, err
+			}
+
+			// A reboot that started but never completed is abandoned rather than succeeded or failed
+			if rebootNode.Status.StartTime != nil && rebootNode.Status.CompletionTime == nil {
+				logger.Info("rebootnode deleted before the reboot completed, reboot abandoned",
+					"node", rebootNode.Spec.NodeName)
 
-			controllerutil.RemoveFinalizer(&rebootNode, RebootNodeFinalizer)
+				metrics.GlobalMetrics.IncRebootAbandoned()
 
-			if err := r.Update(ctx, &rebootNode); err != nil {
-				return ctrl.Result{}, err
+				if r.Config != nil && r.Config.NodeCondition.Enabled {
+					r.setRebootNodeCondition(ctx, &rebootNode, corev1.ConditionFalse, NodeConditionReasonAbandoned,
+						fmt.Sprintf("RebootNode %s was deleted before the reboot completed", rebootNode.Name))
+				}
 			}
 		}
 
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, metrics.ReconcileOutcomeNoop, nil
 	}
 
 	// Add finalizer if not present
-	if !controllerutil.ContainsFinalizer(&rebootNode, RebootNodeFinalizer) {
-		controllerutil.AddFinalizer(&rebootNode, RebootNodeFinalizer)
-
-		if err := r.Update(ctx, &rebootNode); err != nil {
-			return ctrl.Result{}, err
+	if !controllerutil.ContainsFinalizer(&rebootNode, r.getFinalizerName()) {
+		if err := addFinalizer(ctx, r.Client, &rebootNode, r.getFinalizerName()); err != nil {
+			return ctrl.Result{}, metrics.ReconcileOutcomeFailed, err
 		}
 	}
 
@@ -127,7 +263,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		logger.V(1).Info("rebootnode has completion time set, skipping reconcile",
 			"node", rebootNode.Spec.NodeName)
 
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, metrics.ReconcileOutcomeNoop, nil
 	}
 
 	// Take a deep copy to compare against at the end
@@ -135,18 +271,45 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	var result ctrl.Result
 
+	if isRebootNodePaused(&rebootNode) {
+		return r.pauseRebootNode(ctx, req, originalRebootNode, &rebootNode)
+	}
+
+	if remaining := remainingBackoff(&rebootNode); remaining > 0 {
+		logger.V(1).Info("rebootnode is backing off, waiting for the next attempt",
+			"node", rebootNode.Spec.NodeName,
+			"consecutiveFailures", int(rebootNode.Status.ConsecutiveFailures),
+			"remaining", remaining)
+
+		return ctrl.Result{RequeueAfter: remaining}, metrics.ReconcileOutcomeRequeued, nil
+	}
+
+	resumeRebootNode(ctx, &rebootNode)
+
 	// Initialize conditions if not already set
 	rebootNode.SetInitialConditions()
 
 	// Set the start time if it is not already set
 	rebootNode.SetStartTime()
 
+	// Get the node to reboot. Its annotations may tune the timeout and retry limit, so a missing
+	// node is only handled after the retry limit has been checked.
+	var node corev1.Node
+
+	nodeErr := r.nodeGetter().GetNode(ctx, rebootNode.Spec.NodeName, &node)
+	if nodeErr != nil && !apierrors.IsNotFound(nodeErr) {
+		return ctrl.Result{}, metrics.ReconcileOutcomeFailed, nodeErr
+	}
+
+	rebootTimeout := r.getRebootTimeoutForNode(ctx, &node)
+	maxRetries := r.getMaxRetriesForNode(ctx, &node)
+
 	// Check if max retries exceeded
-	if rebootNode.Status.RetryCount >= MaxRebootRetries {
+	if rebootNode.Status.RetryCount >= maxRetries {
 		logger.Info("max retries exceeded, marking as failed",
 			"node", rebootNode.Spec.NodeName,
 			"retries", int(rebootNode.Status.RetryCount),
-			"maxRetries", MaxRebootRetries)
+			"maxRetries", maxRetries)
 
 		rebootNode.SetCompletionTime()
 		rebootNode.SetCondition(metav1.Condition{
@@ -154,35 +317,45 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			Status: metav1.ConditionFalse,
 			Reason: "MaxRetriesExceeded",
 			Message: fmt.Sprintf("Node failed to reach ready state after %d retries over %s",
-				MaxRebootRetries, r.getRebootTimeout()),
+				maxRetries, rebootTimeout),
 			LastTransitionTime: metav1.Now(),
 		})
 
-		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, rebootNode.Spec.NodeName)
+		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, rebootNode.Spec.NodeName, node.Labels)
 
 		result = ctrl.Result{} // Don't requeue
 
 		// Update status and return
-		return r.updateRebootNodeStatus(ctx, req, originalRebootNode, &rebootNode, result)
+		return r.finishReconcile(ctx, req, originalRebootNode, &rebootNode, result)
 	}
 
-	// Get the node to reboot
-	var node corev1.Node
-	if err := r.Get(ctx, client.ObjectKey{Name: rebootNode.Spec.NodeName}, &node); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+	if nodeErr != nil {
+		return ctrl.Result{}, metrics.ReconcileOutcomeNoop, nil
 	}
 
 	// Check if reboot has already started
 	if rebootNode.IsRebootInProgress() {
+		// The status write recording the signal triggers a reconcile right away; hold off until the
+		// first check is due so that it neither queries the CSP early nor counts as a retry
+		if wait := r.untilFirstCheck(&rebootNode); wait > 0 {
+			logger.V(1).Info("waiting for the first check after the reboot signal",
+				"node", node.Name,
+				"remaining", wait)
+
+			result = ctrl.Result{RequeueAfter: wait}
+
+			return r.finishReconcile(ctx, req, originalRebootNode, &rebootNode, result)
+		}
+
 		// Increment retry count for monitoring attempts
 		rebootNode.Status.RetryCount++
 
-		// Check if csp reports the node is ready
+		// Check if csp reports the reboot operation is done and the node is ready
 		cspReady := false
 
 		var nodeReadyErr error
 
-		if r.Config.ManualMode {
+		if r.Config.ManualMode || r.Config.ReadinessMode == config.ReadinessModeKubernetesOnly {
 			cspReady = true
 			nodeReadyErr = nil
 		} else {
@@ -190,13 +363,44 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			cspCtx, cancel := context.WithTimeout(ctx, CSPOperationTimeout)
 			defer cancel()
 
-			cspReady, nodeReadyErr = r.CSPClient.IsNodeReady(cspCtx, node, rebootNode.GetCSPReqRef())
+			// The node health is only meaningful once the CSP operation itself has finished
+			operation := "IsRebootComplete"
+
+			rebootComplete, err := r.CSPClient.IsRebootComplete(cspCtx, node, rebootNode.GetCSPReqRef())
+			if errors.Is(err, model.ErrCSPRequestExpired) {
+				// The CSP dropped the operation during a long reboot; check the instance itself instead
+				logger.Info("CSP reboot operation reference expired, checking the node directly",
+					"node", node.Name,
+					"cspRef", rebootNode.GetCSPReqRef())
+
+				rebootComplete, err = true, nil
+			}
+
+			if err == nil && rebootComplete {
+				operation = "IsNodeReady"
+				cspReady, err = r.CSPClient.IsNodeReady(cspCtx, node, rebootNode.GetCSPReqRef())
+
+				if errors.Is(err, model.ErrCSPRequestExpired) {
+					// Without a usable reference the node readiness and boot ID reported by Kubernetes decide
+					logger.Info("CSP request reference expired, relying on the Kubernetes node status",
+						"node", node.Name,
+						"cspRef", rebootNode.GetCSPReqRef())
+
+					cspReady, err = true, nil
+				}
+			} else if err == nil {
+				logger.V(1).Info("CSP reboot operation not complete yet",
+					"node", node.Name,
+					"cspRef", rebootNode.GetCSPReqRef())
+			}
+
+			nodeReadyErr = model.NewCSPError(r.cspProvider, operation, node.Name, rebootNode.GetCSPReqRef(), err)
 
 			// Check for timeout specifically
 			if errors.Is(nodeReadyErr, context.DeadlineExceeded) {
 				logger.Info("CSP operation timed out, will retry",
 					"node", node.Name,
-					"operation", "IsNodeReady",
+					"operation", operation,
 					"timeout", CSPOperationTimeout)
 
 				rebootNode.Status.ConsecutiveFailures++
@@ -204,17 +408,31 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 				result = ctrl.Result{RequeueAfter: delay}
 				// Update status and return early
-				return r.updateRebootNodeStatus(ctx, req, originalRebootNode, &rebootNode, result)
+				return r.finishReconcile(ctx, req, originalRebootNode, &rebootNode, result)
 			}
 		}
 
 		// Check if kubernetes reports the node is ready.
-		kubernetesReady := false
+		kubernetesReady := isNodeReady(&node)
 
-		for _, condition := range node.Status.Conditions {
-			if condition.Type == corev1.NodeReady {
-				kubernetesReady = condition.Status == corev1.ConditionTrue
-			}
+		// A Ready report must come with proof of the reboot, otherwise it may simply be the
+		// node never having gone down or the original outage recovering on its own.
+		rebootObserved := r.rebootVerifier().rebootObserved(&rebootNode, &node)
+
+		if r.Config.ReadinessMode == config.ReadinessModeCSPOnly && !r.Config.ManualMode {
+			// The CSP report alone decides, e.g. where the kubelet is slow to report after a reboot
+			kubernetesReady, rebootObserved = true, true
+		}
+
+		if kubernetesReady && !rebootObserved {
+			logger.Info("node is ready but the reboot has not been observed yet, waiting for reboot",
+				"node", node.Name,
+				"bootID", node.Status.NodeInfo.BootID,
+				"verification", r.Config.VerificationStrategy)
+		}
+
+		if nodeReadyErr == nil {
+			r.trackReadySince(ctx, &rebootNode, cspReady && kubernetesReady && rebootObserved)
 		}
 
 		// nolint:gocritic // Migrated business logic with if-else chain
@@ -233,10 +451,20 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				LastTransitionTime: metav1.Now(),
 			})
 
-			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name)
+			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
 
 			result = ctrl.Result{} // Don't requeue on failure
-		} else if cspReady && kubernetesReady {
+		} else if cspReady && kubernetesReady && rebootObserved && r.shouldWaitForReadyWindow(&rebootNode) {
+			result = r.waitForReadyWindow(ctx, &rebootNode, &node)
+		} else if cspReady && kubernetesReady && rebootObserved && r.shouldWaitForGPUs(&node) {
+			result = r.waitForGPUs(ctx, &rebootNode, &node)
+		} else if cspReady && kubernetesReady && rebootObserved && r.shouldRunPostRebootJob(&rebootNode) {
+			result = r.runPostRebootJob(ctx, &rebootNode, &node)
+		} else if cspReady && kubernetesReady && rebootObserved && r.shouldVerifyStability(&rebootNode) {
+			result = r.verifyStability(ctx, &rebootNode, &node)
+		} else if cspReady && kubernetesReady && rebootObserved && shouldRemoveTaint(&rebootNode, &node) {
+			result = r.removeTaint(ctx, &rebootNode, &node)
+		} else if cspReady && kubernetesReady && rebootObserved {
 			logger.Info("node reached ready state post-reboot",
 				"node", node.Name,
 				"duration", time.Since(rebootNode.Status.StartTime.Time))
@@ -244,6 +472,16 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			// Reset failure counters on success
 			rebootNode.Status.ConsecutiveFailures = 0
 
+			if r.Config.GPUReadiness.Enabled {
+				rebootNode.SetCondition(metav1.Condition{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady,
+					Status:             metav1.ConditionTrue,
+					Reason:             "GPUsAvailable",
+					Message:            "Node GPUs are available post-reboot",
+					LastTransitionTime: metav1.Now(),
+				})
+			}
+
 			// Update status
 			rebootNode.SetCompletionTime()
 			rebootNode.SetCondition(metav1.Condition{
@@ -255,14 +493,26 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			})
 
 			// Metrics and final result
-			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusSucceeded, node.Name)
-			metrics.GlobalMetrics.RecordActionMTTR(metrics.ActionTypeReboot, time.Since(rebootNode.Status.StartTime.Time))
+			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusSucceeded, node.Name, node.Labels)
+			metrics.GlobalMetrics.RecordActionMTTR(metrics.ActionTypeReboot, node.Labels, time.Since(rebootNode.Status.StartTime.Time))
 
 			result = ctrl.Result{} // Don't requeue on success
-		} else if time.Since(rebootNode.Status.StartTime.Time) > r.getRebootTimeout() {
+		} else if isVerifyingStability(&rebootNode) {
+			result = r.failFlappedReboot(ctx, &rebootNode, &node)
+		} else if time.Since(rebootNode.Status.StartTime.Time) > rebootTimeout && r.shouldHoldForDegradedCluster(ctx, &node) {
+			result = r.holdForDegradedCluster(ctx, &rebootNode, &node)
+		} else if time.Since(rebootNode.Status.StartTime.Time) > rebootTimeout && r.shouldEscalateToHardReboot(&rebootNode) {
+			logger.Info("soft reboot timed out, escalating to hard reboot",
+				"node", node.Name,
+				"timeout", rebootTimeout)
+
+			result = r.escalateToHardReboot(ctx, &rebootNode, &node)
+		} else if time.Since(rebootNode.Status.StartTime.Time) > rebootTimeout && kubernetesReady && !rebootObserved {
+			result = r.failUnobservedReboot(ctx, &rebootNode, &node)
+		} else if time.Since(rebootNode.Status.StartTime.Time) > rebootTimeout {
 			logger.Error(nil, "node reboot timed out",
 				"node", node.Name,
-				"timeout", r.getRebootTimeout(),
+				"timeout", rebootTimeout,
 				"elapsed", time.Since(rebootNode.Status.StartTime.Time))
 
 			// Update status
@@ -275,7 +525,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				LastTransitionTime: metav1.Now(),
 			})
 
-			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name)
+			metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
 
 			result = ctrl.Result{} // Don't requeue on timeout
 		} else {
@@ -302,6 +552,31 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 			delay := getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures)
 			result = ctrl.Result{RequeueAfter: delay}
+		} else if isRebootExcluded(&node) {
+			logger.Info("node is excluded from reboots by annotation, janitor will not send reboot signal",
+				"node", node.Name,
+				"annotation", RebootExcludeAnnotation)
+
+			rebootNode.SetCompletionTime()
+			rebootNode.SetCondition(metav1.Condition{
+				Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootExcluded,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ExcludedByAnnotation",
+				Message:            fmt.Sprintf("Node is annotated with %s", RebootExcludeAnnotation),
+				LastTransitionTime: metav1.Now(),
+			})
+
+			result = ctrl.Result{} // Don't requeue, the exclusion is terminal
+		} else if r.shouldRefuseControlPlaneReboot(&rebootNode, &node) {
+			result = r.refuseControlPlaneReboot(ctx, &rebootNode, &node)
+		} else if budgetWait := r.rebootBudgetWait(ctx, &rebootNode); budgetWait > 0 {
+			result = r.deferForRebootBudget(ctx, &rebootNode, budgetWait)
+		} else if !r.hasSpareCapacity(ctx, &rebootNode) {
+			result = r.deferForSpareCapacity(ctx, &rebootNode)
+		} else if !r.acquireRebootSlot(ctx, &rebootNode) {
+			result = r.waitForRebootSlot(ctx, &rebootNode)
+		} else if r.shouldRunPreRebootJob(&rebootNode) {
+			result = r.runPreRebootJob(ctx, &rebootNode, &node)
 		} else {
 			if r.Config.ManualMode {
 				isManualModeConditionSet := false
@@ -315,6 +590,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 				if !isManualModeConditionSet {
 					now := metav1.Now()
+					rebootNode.Status.SignalSentTime = &now
 					rebootNode.SetCondition(metav1.Condition{
 						Type:               janitordgxcnvidiacomv1alpha1.ManualModeConditionType,
 						Status:             metav1.ConditionTrue,
@@ -322,7 +598,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 						Message:            "Janitor is in manual mode, outside actor required to send reboot signal",
 						LastTransitionTime: now,
 					})
-					metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusStarted, node.Name)
+					metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusStarted, node.Name, node.Labels)
 				}
 
 				logger.Info("manual mode enabled, janitor will not send reboot signal",
@@ -331,15 +607,17 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				result = ctrl.Result{}
 			} else {
 				// Start the reboot process
-				metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusStarted, node.Name)
+				metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusStarted, node.Name, node.Labels)
 				logger.Info("sending reboot signal to node",
-					"node", node.Name)
+					"node", node.Name,
+					"nodeReady", isNodeReady(&node))
 
 				// Add timeout to CSP operation
 				cspCtx, cancel := context.WithTimeout(ctx, CSPOperationTimeout)
 				defer cancel()
 
-				reqRef, rebootErr := r.CSPClient.SendRebootSignal(cspCtx, node)
+				reqRef, rebootErr := r.CSPClient.SendRebootSignal(cspCtx, node, rebootOptionsFor(&rebootNode))
+				rebootErr = model.NewCSPError(r.cspProvider, "SendRebootSignal", node.Name, "", rebootErr)
 
 				// Check for timeout
 				if errors.Is(rebootErr, context.DeadlineExceeded) {
@@ -353,7 +631,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 					result = ctrl.Result{RequeueAfter: delay}
 					// Update status and return early
-					return r.updateRebootNodeStatus(ctx, req, originalRebootNode, &rebootNode, result)
+					return r.finishReconcile(ctx, req, originalRebootNode, &rebootNode, result)
 				}
 
 				// Update status based on reboot result
@@ -363,6 +641,9 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 					// Reset consecutive failures on success
 					rebootNode.Status.ConsecutiveFailures = 0
 
+					r.rebootVerifier().recordPreReboot(&rebootNode, &node)
+					rebootNode.SetSignalSentTime()
+
 					signalSentCondition = metav1.Condition{
 						Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
 						Status:             metav1.ConditionTrue,
@@ -371,7 +652,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 						LastTransitionTime: metav1.Now(),
 					}
 					// Continue monitoring if signal was sent successfully
-					result = ctrl.Result{RequeueAfter: 30 * time.Second}
+					result = ctrl.Result{RequeueAfter: r.getFirstCheckDelay()}
 				} else {
 					rebootNode.Status.ConsecutiveFailures++
 
@@ -387,7 +668,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 					// Don't requeue on failure
 					result = ctrl.Result{}
 
-					metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name)
+					metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
 				}
 
 				rebootNode.SetCondition(signalSentCondition)
@@ -396,7 +677,7 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Update status if changed and return
-	return r.updateRebootNodeStatus(ctx, req, originalRebootNode, &rebootNode, result)
+	return r.finishReconcile(ctx, req, originalRebootNode, &rebootNode, result)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -407,19 +688,252 @@ func (r *RebootNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	var err error
 
-	r.CSPClient, err = csp.New(ctx)
+	cspClient, err := csp.New(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create CSP client: %w", err)
 	}
 
+	r.CSPClient = csp.WithRetries(cspClient, csp.RetryConfig{
+		MaxRetries:   r.Config.CSPRetry.MaxRetries,
+		InitialDelay: r.Config.CSPRetry.InitialDelay,
+		MaxDelay:     r.Config.CSPRetry.MaxDelay,
+	})
+
+	provider, err := csp.GetProviderFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to determine CSP provider: %w", err)
+	}
+
+	r.cspProvider = string(provider)
+
+	if err := mgr.Add(&rebootNodePhaseReporter{
+		client:   mgr.GetClient(),
+		interval: rebootNodePhaseReportInterval,
+	}); err != nil {
+		return fmt.Errorf("failed to add rebootnode phase reporter: %w", err)
+	}
+
+	// Manual mode leaves RebootNodes unfinished without requeueing them, so there is nothing to detect
+	if r.Config != nil && r.Config.LivenessWindow > 0 && !r.Config.ManualMode {
+		r.liveness = newReconcileLiveness(mgr.GetClient(), r.Config.LivenessWindow)
+
+		if err := mgr.Add(r.liveness); err != nil {
+			return fmt.Errorf("failed to add rebootnode reconcile liveness: %w", err)
+		}
+
+		if err := mgr.AddHealthzCheck(rebootNodeLivenessCheckName, r.liveness.Check); err != nil {
+			return fmt.Errorf("failed to add rebootnode reconcile liveness check: %w", err)
+		}
+	}
+
 	// Note: We use RequeueAfter in the reconcile loop rather than the controller's
 	// rate limiter because we need per-resource (per-node) backoff based on each
 	// node's individual failure count, not per-controller rate limiting.
 	// This allows nodes with consecutive failures to back off independently.
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
-		Named("rebootnode").
-		Complete(r)
+		Named("rebootnode")
+
+	// The node watch only speeds up completion; polling still detects it when the watch is unavailable
+	if r.Config != nil && r.Config.WatchNodes {
+		r.watchNodes(ctx, b, mgr.GetClient())
+	}
+
+	return b.Complete(r)
+}
+
+// isSLABreached returns true if an SLA is configured and the completed RebootNode took longer than it,
+// measured from creation so that time spent waiting to be picked up counts against the SLA
+func (r *RebootNodeReconciler) isSLABreached(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if r.Config == nil || r.Config.SLA <= 0 || rebootNode.Status.CompletionTime == nil {
+		return false
+	}
+
+	return rebootNode.Status.CompletionTime.Sub(rebootNode.CreationTimestamp.Time) > r.Config.SLA
+}
+
+// shouldEscalateToHardReboot returns true if a timed out reboot should be retried as a hard reboot.
+// Only soft reboots escalate, and only once.
+func (r *RebootNodeReconciler) shouldEscalateToHardReboot(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if r.Config == nil || !r.Config.EscalateToHardReboot || r.Config.ManualMode {
+		return false
+	}
+
+	if rebootNode.Spec.RebootType == janitordgxcnvidiacomv1alpha1.RebootTypeHard {
+		return false
+	}
+
+	return meta.FindStatusCondition(rebootNode.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot) == nil
+}
+
+// escalateToHardReboot sends a hard reboot signal for a timed out soft reboot and restarts the
+// monitoring window. If the signal cannot be sent the reboot fails as timed out.
+func (r *RebootNodeReconciler) escalateToHardReboot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	logger := log.FromContext(ctx)
+
+	opts := rebootOptionsFor(rebootNode)
+	opts.RebootType = model.RebootTypeHard
+
+	cspCtx, cancel := context.WithTimeout(ctx, CSPOperationTimeout)
+	defer cancel()
+
+	reqRef, err := r.CSPClient.SendRebootSignal(cspCtx, *node, opts)
+	err = model.NewCSPError(r.cspProvider, "SendRebootSignal", node.Name, "", err)
+	if err != nil {
+		logger.Error(err, "failed to send hard reboot signal", "node", node.Name)
+
+		rebootNode.SetCompletionTime()
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot,
+			Status:             metav1.ConditionFalse,
+			Reason:             "Failed",
+			Message:            err.Error(),
+			LastTransitionTime: metav1.Now(),
+		})
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             "Timeout",
+			Message:            "Node failed to return to ready state after timeout duration and hard reboot could not be sent",
+			LastTransitionTime: metav1.Now(),
+		})
+
+		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+		return ctrl.Result{}
+	}
+
+	now := metav1.Now()
+
+	// Restart the monitoring window for the hard reboot
+	rebootNode.Status.StartTime = &now
+	rebootNode.Status.RetryCount = 0
+	rebootNode.Status.ConsecutiveFailures = 0
+	r.rebootVerifier().recordPreReboot(rebootNode, node)
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot,
+		Status:             metav1.ConditionTrue,
+		Reason:             "SoftRebootTimedOut",
+		Message:            string(reqRef),
+		LastTransitionTime: now,
+	})
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Succeeded",
+		Message:            string(reqRef),
+		LastTransitionTime: now,
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusStarted, node.Name, node.Labels)
+
+	return ctrl.Result{RequeueAfter: r.getFirstCheckDelay()}
+}
+
+// rebootOptionsFor translates the RebootNode spec into the options passed to the CSP, defaulting to a soft reboot
+func rebootOptionsFor(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) model.RebootOptions {
+	rebootType := model.RebootTypeSoft
+	if rebootNode.Spec.RebootType == janitordgxcnvidiacomv1alpha1.RebootTypeHard {
+		rebootType = model.RebootTypeHard
+	}
+
+	return model.RebootOptions{
+		RebootType: rebootType,
+		Tags: map[string]string{
+			"rebootNode": rebootNode.Name,
+		},
+	}
+}
+
+// isNodeReady returns true if the node reports a Ready condition with status True
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// isRebootExcluded returns true if the node carries a truthy RebootExcludeAnnotation
+func isRebootExcluded(node *corev1.Node) bool {
+	return stringutil.IsTruthyValue(node.Annotations[RebootExcludeAnnotation])
+}
+
+// isRebootNodePaused returns true if the RebootNode carries a truthy PauseAnnotation
+func isRebootNodePaused(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	return stringutil.IsTruthyValue(rebootNode.Annotations[PauseAnnotation])
+}
+
+// pauseRebootNode records that the RebootNode is paused and requeues it without advancing the reboot.
+// CSP operations already in flight are not cancelled, they are just not checked while paused.
+func (r *RebootNodeReconciler) pauseRebootNode(
+	ctx context.Context,
+	req ctrl.Request,
+	original *janitordgxcnvidiacomv1alpha1.RebootNode,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) (ctrl.Result, string, error) {
+	if !meta.IsStatusConditionTrue(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused) {
+		log.FromContext(ctx).Info("rebootnode paused by annotation",
+			"node", rebootNode.Spec.NodeName,
+			"annotation", PauseAnnotation)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused,
+			Status:             metav1.ConditionTrue,
+			Reason:             "PausedByAnnotation",
+			Message:            fmt.Sprintf("RebootNode is annotated with %s", PauseAnnotation),
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	result := ctrl.Result{RequeueAfter: getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures)}
+
+	return r.finishReconcile(ctx, req, original, rebootNode, result)
+}
+
+// resumeRebootNode records that a paused RebootNode was resumed. The start time moves forward by the
+// time spent paused, so the pause does not count against the reboot timeout.
+func resumeRebootNode(ctx context.Context, rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) {
+	paused := meta.FindStatusCondition(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused)
+	if paused == nil || paused.Status != metav1.ConditionTrue {
+		return
+	}
+
+	pausedFor := time.Since(paused.LastTransitionTime.Time)
+
+	log.FromContext(ctx).Info("rebootnode resumed",
+		"node", rebootNode.Spec.NodeName,
+		"pausedFor", pausedFor)
+
+	if rebootNode.Status.StartTime != nil {
+		rebootNode.Status.StartTime = &metav1.Time{Time: rebootNode.Status.StartTime.Add(pausedFor)}
+	}
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Resumed",
+		Message:            fmt.Sprintf("RebootNode resumed after being paused for %s", pausedFor.Round(time.Second)),
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// getFinalizerName returns the finalizer managed by this reconciler instance
+func (r *RebootNodeReconciler) getFinalizerName() string {
+	cfg := r.Config
+	if cfg == nil || cfg.FinalizerName == "" {
+		return RebootNodeFinalizer
+	}
+
+	return cfg.FinalizerName
 }
 
 // getRebootTimeout returns the timeout for reboot operations
@@ -431,3 +945,87 @@ func (r *RebootNodeReconciler) getRebootTimeout() time.Duration {
 
 	return cfg.Timeout
 }
+
+// getFirstCheckDelay returns how long to wait after sending a reboot signal before first checking the node
+func (r *RebootNodeReconciler) getFirstCheckDelay() time.Duration {
+	if r.Config == nil || r.Config.FirstCheckDelay <= 0 {
+		return DefaultFirstCheckDelay
+	}
+
+	return r.Config.FirstCheckDelay
+}
+
+// untilFirstCheck returns how long remains until the node is first checked after the latest reboot
+// signal, which is the initial signal or the hard reboot escalation, or zero if the check is due
+func (r *RebootNodeReconciler) untilFirstCheck(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) time.Duration {
+	var sentAt time.Time
+
+	for _, conditionType := range []string{
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot,
+	} {
+		condition := meta.FindStatusCondition(rebootNode.Status.Conditions, conditionType)
+		if condition != nil && condition.Status == metav1.ConditionTrue && condition.LastTransitionTime.After(sentAt) {
+			sentAt = condition.LastTransitionTime.Time
+		}
+	}
+
+	if sentAt.IsZero() {
+		return 0
+	}
+
+	return max(time.Until(sentAt.Add(r.getFirstCheckDelay())), 0)
+}
+
+// getRebootTimeoutForNode returns the reboot timeout for the given node. A valid RebootTimeoutAnnotation
+// on the node takes precedence over the controller configuration; malformed values are logged and ignored.
+func (r *RebootNodeReconciler) getRebootTimeoutForNode(ctx context.Context, node *corev1.Node) time.Duration {
+	value, ok := node.Annotations[RebootTimeoutAnnotation]
+	if !ok {
+		return r.getRebootTimeout()
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.FromContext(ctx).Info("ignoring malformed node annotation",
+			"node", node.Name,
+			"annotation", RebootTimeoutAnnotation,
+			"value", value)
+
+		return r.getRebootTimeout()
+	}
+
+	return timeout
+}
+
+// requeueUncounted requeues a reboot in progress after the given delay, undoing the retry counted by
+// the current reconcile. Waits bounded by a timeout or delay of their own use it so that their checks
+// do not run into the retry limit before that bound.
+func requeueUncounted(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, after time.Duration) ctrl.Result {
+	if rebootNode.Status.RetryCount > 0 {
+		rebootNode.Status.RetryCount--
+	}
+
+	return ctrl.Result{RequeueAfter: after}
+}
+
+// getMaxRetriesForNode returns the retry limit for the given node. A valid MaxRetriesAnnotation on the
+// node takes precedence over MaxRebootRetries; malformed values are logged and ignored.
+func (r *RebootNodeReconciler) getMaxRetriesForNode(ctx context.Context, node *corev1.Node) int32 {
+	value, ok := node.Annotations[MaxRetriesAnnotation]
+	if !ok {
+		return MaxRebootRetries
+	}
+
+	maxRetries, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || maxRetries <= 0 {
+		log.FromContext(ctx).Info("ignoring malformed node annotation",
+			"node", node.Name,
+			"annotation", MaxRetriesAnnotation,
+			"value", value)
+
+		return MaxRebootRetries
+	}
+
+	return int32(maxRetries)
+}
@@ -15,41 +15,81 @@
 package controller
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/audit"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
 	"github.com/nvidia/nvsentinel/janitor/pkg/model"
 )
 
+// finishJob marks a hook Job as finished with the given condition
+func finishJob(ctx context.Context, c client.Client, key types.NamespacedName, conditionType batchv1.JobConditionType) {
+	var job batchv1.Job
+	Expect(c.Get(ctx, key, &job)).To(Succeed())
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionTrue,
+		Message: "job finished",
+	})
+	Expect(c.Status().Update(ctx, &job)).To(Succeed())
+}
+
 // Mock CSP client for testing
 type mockCSPClient struct {
 	sendRebootSignalCalled int
 	sendRebootSignalError  error
 	sendRebootSignalResult model.ResetSignalRequestRef
+	sendRebootSignalOpts   model.RebootOptions
 	isNodeReadyResult      bool
 	isNodeReadyError       error
+	isNodeReadyCalled      int
+	// rebootIncomplete and isRebootCompleteError gate IsNodeReady; the zero value reports the operation done
+	rebootIncomplete      bool
+	isRebootCompleteError error
 }
 
-func (m *mockCSPClient) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+func (m *mockCSPClient) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
 	m.sendRebootSignalCalled++
+	m.sendRebootSignalOpts = opts
 	return m.sendRebootSignalResult, m.sendRebootSignalError
 }
 
+func (m *mockCSPClient) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return !m.rebootIncomplete, m.isRebootCompleteError
+}
+
 func (m *mockCSPClient) IsNodeReady(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	m.isNodeReadyCalled++
 	return m.isNodeReadyResult, m.isNodeReadyError
 }
 
@@ -57,6 +97,50 @@ func (m *mockCSPClient) SendTerminateSignal(ctx context.Context, node corev1.Nod
 	return model.TerminateNodeRequestRef(""), nil
 }
 
+// stubNodeGetter returns a fixed node state instead of reading the node from the client
+type stubNodeGetter struct {
+	node  *corev1.Node
+	err   error
+	calls int
+}
+
+func (g *stubNodeGetter) GetNode(ctx context.Context, name string, node *corev1.Node) error {
+	g.calls++
+
+	if g.err != nil {
+		return g.err
+	}
+
+	g.node.DeepCopyInto(node)
+
+	return nil
+}
+
+// pendingOperationCSPClient reports whether a CSP operation is pending on the node
+type pendingOperationCSPClient struct {
+	*mockCSPClient
+	pending bool
+	checks  int
+}
+
+func (m *pendingOperationCSPClient) HasPendingOperation(ctx context.Context, node corev1.Node) (bool, error) {
+	m.checks++
+	return m.pending, nil
+}
+
+// cancellingCSPClient can also cancel the pending CSP operation on the node
+type cancellingCSPClient struct {
+	*pendingOperationCSPClient
+	cancelled []string
+}
+
+func (m *cancellingCSPClient) CancelPendingOperation(ctx context.Context, node corev1.Node) error {
+	m.cancelled = append(m.cancelled, node.Name)
+	m.pending = false
+
+	return nil
+}
+
 func TestRebootNodeReconciler_getRebootTimeout(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -98,6 +182,359 @@ func TestRebootNodeReconciler_getRebootTimeout(t *testing.T) {
 	}
 }
 
+func TestRebootNodeReconciler_FirstCheckDelay(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        *config.RebootNodeControllerConfig
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "defaults when not configured",
+			config:        &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute},
+			expectedDelay: DefaultFirstCheckDelay,
+		},
+		{
+			name: "uses the configured delay",
+			config: &config.RebootNodeControllerConfig{
+				Timeout:         30 * time.Minute,
+				FirstCheckDelay: 3 * time.Minute,
+			},
+			expectedDelay: 3 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "first-check-rebootnode"},
+				Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+			}
+
+			c, _ := newConflictingClient(t, 0, node, rebootNode)
+
+			cspClient := &mockCSPClient{sendRebootSignalResult: model.ResetSignalRequestRef("ref")}
+			r := &RebootNodeReconciler{
+				Client:    c,
+				Config:    tt.config,
+				CSPClient: cspClient,
+			}
+
+			ctx := context.Background()
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: rebootNode.Name}}
+
+			result, err := r.Reconcile(ctx, req)
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if result.RequeueAfter != tt.expectedDelay {
+				t.Errorf("Reconcile() RequeueAfter = %v, want %v", result.RequeueAfter, tt.expectedDelay)
+			}
+
+			// The status write recording the signal triggers a reconcile before the delay elapsed
+			result, err = r.Reconcile(ctx, req)
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if result.RequeueAfter <= 0 || result.RequeueAfter > tt.expectedDelay {
+				t.Errorf("Reconcile() before the first check RequeueAfter = %v, want within %v", result.RequeueAfter, tt.expectedDelay)
+			}
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			if err := c.Get(ctx, req.NamespacedName, &updated); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			if cspClient.isNodeReadyCalled != 0 || updated.Status.RetryCount != 0 {
+				t.Errorf("Reconcile() before the first check queried the node %d times with RetryCount %d, want neither",
+					cspClient.isNodeReadyCalled, updated.Status.RetryCount)
+			}
+
+			// Once the delay elapsed the node is checked
+			for i := range updated.Status.Conditions {
+				if updated.Status.Conditions[i].Type == janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent {
+					updated.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-tt.expectedDelay))
+				}
+			}
+
+			if err := c.Status().Update(ctx, &updated); err != nil {
+				t.Fatalf("Status().Update() error = %v", err)
+			}
+
+			if _, err := r.Reconcile(ctx, req); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if cspClient.isNodeReadyCalled != 1 {
+				t.Errorf("Reconcile() after the delay queried the node %d times, want 1", cspClient.isNodeReadyCalled)
+			}
+		})
+	}
+}
+
+func TestRebootNodeReconciler_getFinalizerName(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *config.RebootNodeControllerConfig
+		expected string
+	}{
+		{
+			name:     "no config - uses default finalizer",
+			config:   nil,
+			expected: RebootNodeFinalizer,
+		},
+		{
+			name:     "empty finalizer name - uses default finalizer",
+			config:   &config.RebootNodeControllerConfig{},
+			expected: RebootNodeFinalizer,
+		},
+		{
+			name:     "custom finalizer name",
+			config:   &config.RebootNodeControllerConfig{FinalizerName: "janitor.dgxc.nvidia.com/instance-b"},
+			expected: "janitor.dgxc.nvidia.com/instance-b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RebootNodeReconciler{Config: tt.config}
+
+			if got := r.getFinalizerName(); got != tt.expected {
+				t.Errorf("getFinalizerName() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRebootNodeReconciler_getRebootTimeoutForNode(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          *config.RebootNodeControllerConfig
+		annotations     map[string]string
+		expectedTimeout time.Duration
+	}{
+		{
+			name:            "no annotation - uses controller config",
+			config:          &config.RebootNodeControllerConfig{Timeout: 20 * time.Minute},
+			expectedTimeout: 20 * time.Minute,
+		},
+		{
+			name:            "valid annotation - takes precedence over controller config",
+			config:          &config.RebootNodeControllerConfig{Timeout: 20 * time.Minute},
+			annotations:     map[string]string{RebootTimeoutAnnotation: "45m"},
+			expectedTimeout: 45 * time.Minute,
+		},
+		{
+			name:            "valid annotation - takes precedence over fallback default",
+			config:          nil,
+			annotations:     map[string]string{RebootTimeoutAnnotation: "10m"},
+			expectedTimeout: 10 * time.Minute,
+		},
+		{
+			name:            "malformed annotation - ignored",
+			config:          &config.RebootNodeControllerConfig{Timeout: 20 * time.Minute},
+			annotations:     map[string]string{RebootTimeoutAnnotation: "forever"},
+			expectedTimeout: 20 * time.Minute,
+		},
+		{
+			name:            "non-positive annotation - ignored",
+			config:          &config.RebootNodeControllerConfig{Timeout: 20 * time.Minute},
+			annotations:     map[string]string{RebootTimeoutAnnotation: "-5m"},
+			expectedTimeout: 20 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RebootNodeReconciler{Config: tt.config}
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node", Annotations: tt.annotations}}
+
+			if got := r.getRebootTimeoutForNode(context.Background(), node); got != tt.expectedTimeout {
+				t.Errorf("getRebootTimeoutForNode() = %v, want %v", got, tt.expectedTimeout)
+			}
+		})
+	}
+}
+
+func TestRebootNodeReconciler_getMaxRetriesForNode(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    int32
+	}{
+		{
+			name:     "no annotation - uses default",
+			expected: MaxRebootRetries,
+		},
+		{
+			name:        "valid annotation",
+			annotations: map[string]string{MaxRetriesAnnotation: "5"},
+			expected:    5,
+		},
+		{
+			name:        "malformed annotation - ignored",
+			annotations: map[string]string{MaxRetriesAnnotation: "five"},
+			expected:    MaxRebootRetries,
+		},
+		{
+			name:        "zero annotation - ignored",
+			annotations: map[string]string{MaxRetriesAnnotation: "0"},
+			expected:    MaxRebootRetries,
+		},
+		{
+			name:        "out of range annotation - ignored",
+			annotations: map[string]string{MaxRetriesAnnotation: "99999999999"},
+			expected:    MaxRebootRetries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RebootNodeReconciler{Config: &config.RebootNodeControllerConfig{}}
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node", Annotations: tt.annotations}}
+
+			if got := r.getMaxRetriesForNode(context.Background(), node); got != tt.expected {
+				t.Errorf("getMaxRetriesForNode() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRebootNodeReconciler_SLA(t *testing.T) {
+	tests := []struct {
+		name           string
+		sla            time.Duration
+		createdAgo     time.Duration
+		expectBreached bool
+	}{
+		{
+			name:           "reboot completing within the SLA",
+			sla:            30 * time.Minute,
+			createdAgo:     10 * time.Minute,
+			expectBreached: false,
+		},
+		{
+			name:           "reboot completing after the SLA",
+			sla:            30 * time.Minute,
+			createdAgo:     45 * time.Minute,
+			expectBreached: true,
+		},
+		{
+			name:           "no SLA configured",
+			createdAgo:     45 * time.Minute,
+			expectBreached: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+
+			startTime := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "sla-rebootnode",
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-tt.createdAgo)),
+					Finalizers:        []string{RebootNodeFinalizer},
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: node.Name},
+				Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+					StartTime: &startTime,
+					Conditions: []metav1.Condition{
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Succeeded",
+							LastTransitionTime: startTime,
+						},
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Initializing",
+							LastTransitionTime: startTime,
+						},
+					},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			if err := corev1.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := janitordgxcnvidiacomv1alpha1.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(node, rebootNode).
+				WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+				Build()
+
+			r := &RebootNodeReconciler{
+				Client:    c,
+				Config:    &config.RebootNodeControllerConfig{Timeout: time.Hour, SLA: tt.sla},
+				CSPClient: &mockCSPClient{isNodeReadyResult: true},
+			}
+
+			breachesBefore := slaBreaches(t)
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: rebootNode.Name},
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			if err := c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &updated); err != nil {
+				t.Fatal(err)
+			}
+
+			if updated.Status.CompletionTime == nil {
+				t.Fatal("expected the reboot to complete")
+			}
+
+			if updated.Status.SLABreached != tt.expectBreached {
+				t.Errorf("SLABreached = %v, want %v", updated.Status.SLABreached, tt.expectBreached)
+			}
+
+			wantBreaches := breachesBefore
+			if tt.expectBreached {
+				wantBreaches++
+			}
+
+			if got := slaBreaches(t); got != wantBreaches {
+				t.Errorf("janitor_reboot_sla_breach_total = %v, want %v", got, wantBreaches)
+			}
+		})
+	}
+}
+
+// slaBreaches returns the current value of the SLA breach counter
+func slaBreaches(t *testing.T) float64 {
+	t.Helper()
+
+	m := gatherMetric(t, "janitor_reboot_sla_breach_total", nil)
+	if m == nil {
+		return 0
+	}
+
+	return m.GetCounter().GetValue()
+}
+
 var _ = Describe("RebootNode Controller", func() {
 	var (
 		ctx            context.Context
@@ -115,6 +552,7 @@ var _ = Describe("RebootNode Controller", func() {
 		// Create scheme and add types
 		scheme = runtime.NewScheme()
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
 		Expect(janitordgxcnvidiacomv1alpha1.AddToScheme(scheme)).To(Succeed())
 
 		// Create test node
@@ -162,6 +600,8 @@ var _ = Describe("RebootNode Controller", func() {
 			CSPClient: mockCSP,
 			Config: &config.RebootNodeControllerConfig{
 				Timeout: 30 * time.Minute,
+				// Check the node on the reconcile right after the reboot signal
+				FirstCheckDelay: time.Nanosecond,
 			},
 		}
 	})
@@ -182,67 +622,2530 @@ var _ = Describe("RebootNode Controller", func() {
 				},
 			}
 
-			result, err := reconciler.Reconcile(ctx, req)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(reconciler.Config.FirstCheckDelay))
+
+			// Verify reboot signal was sent exactly once
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			// Get updated RebootNode
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Verify conditions are properly set
+			Expect(updatedRebootNode.Status.Conditions).To(HaveLen(2))
+
+			// Check SignalSent condition
+			signalSentCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+			Expect(signalSentCondition).NotTo(BeNil())
+			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(signalSentCondition.Reason).To(Equal("Succeeded"))
+			Expect(signalSentCondition.Message).To(Equal("test-request-ref"))
+
+			// Check NodeReady condition (should be Unknown initially)
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionUnknown))
+
+			// Verify StartTime and SignalSentTime are set
+			Expect(updatedRebootNode.Status.StartTime).NotTo(BeNil())
+			Expect(updatedRebootNode.Status.SignalSentTime).NotTo(BeNil())
+			Expect(updatedRebootNode.Status.SignalSentTime.Time).To(BeTemporally(">=", updatedRebootNode.Status.StartTime.Time))
+
+			// Verify IsRebootInProgress returns true
+			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+		})
+
+		It("should NOT send multiple reboot signals on subsequent reconciliations", func() {
+			// First reconciliation - should send reboot signal
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			// Second reconciliation - should NOT send another reboot signal
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1)) // Still 1, not 2!
+
+			// Third reconciliation - should STILL NOT send another reboot signal
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1)) // Still 1, not 3!
+		})
+	})
+
+	Context("when the audit trail is enabled", func() {
+		var (
+			auditBuffer *bytes.Buffer
+			req         reconcile.Request
+		)
+
+		auditRecords := func() []audit.Record {
+			var records []audit.Record
+
+			decoder := json.NewDecoder(bytes.NewReader(auditBuffer.Bytes()))
+			for decoder.More() {
+				var record audit.Record
+				Expect(decoder.Decode(&record)).To(Succeed())
+
+				records = append(records, record)
+			}
+
+			return records
+		}
+
+		auditRecordFor := func(conditionType, toState string) *audit.Record {
+			for _, record := range auditRecords() {
+				if record.Condition == conditionType && record.ToState == toState {
+					return &record
+				}
+			}
+
+			return nil
+		}
+
+		BeforeEach(func() {
+			auditBuffer = &bytes.Buffer{}
+			reconciler.Auditor = audit.NewLogger(auditBuffer)
+			req = reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+		})
+
+		It("should record the signal-sent and success transitions", func() {
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			signalSent := auditRecordFor(janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent, "True")
+			Expect(signalSent).NotTo(BeNil())
+			Expect(signalSent.FromState).To(BeEmpty())
+			Expect(signalSent.Reason).To(Equal("Succeeded"))
+			Expect(signalSent.Node).To(Equal(testNode.Name))
+			Expect(signalSent.Name).To(Equal(testRebootNode.Name))
+			Expect(signalSent.Actor).To(Equal(audit.ActorJanitor))
+			Expect(signalSent.Timestamp.IsZero()).To(BeFalse())
+
+			// Reconciles without a transition add no records
+			mockCSP.isNodeReadyResult = false
+			recorded := len(auditRecords())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(auditRecords()).To(HaveLen(recorded))
+
+			mockCSP.isNodeReadyResult = true
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			succeeded := auditRecordFor(janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady, "True")
+			Expect(succeeded).NotTo(BeNil())
+			Expect(succeeded.FromState).To(Equal("Unknown"))
+			Expect(succeeded.Reason).To(Equal("Succeeded"))
+		})
+
+		It("should record the failure transition", func() {
+			mockCSP.sendRebootSignalError = errors.New("csp unavailable")
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			failed := auditRecordFor(janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent, "False")
+			Expect(failed).NotTo(BeNil())
+			Expect(failed.Node).To(Equal(testNode.Name))
+			Expect(failed.Message).To(ContainSubstring("csp unavailable"))
+		})
+	})
+
+	Context("when the RebootNode selects a reboot type", func() {
+		reconcileOnce := func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		}
+
+		It("should default to a soft reboot", func() {
+			reconcileOnce()
+
+			Expect(mockCSP.sendRebootSignalOpts.RebootType).To(Equal(model.RebootTypeSoft))
+			Expect(mockCSP.sendRebootSignalOpts.Tags).To(HaveKeyWithValue("rebootNode", testRebootNode.Name))
+		})
+
+		It("should pass a hard reboot to the CSP", func() {
+			testRebootNode.Spec.RebootType = janitordgxcnvidiacomv1alpha1.RebootTypeHard
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+
+			reconcileOnce()
+
+			Expect(mockCSP.sendRebootSignalOpts.RebootType).To(Equal(model.RebootTypeHard))
+		})
+	})
+
+	Context("when reboot is in progress", func() {
+		BeforeEach(func() {
+			// Set up RebootNode as if reboot signal was already sent
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+
+			// Update the object in the fake client
+			err := k8sClient.Status().Update(ctx, testRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should monitor node status and complete when node is ready", func() {
+			// Set mock to return node as ready
+			mockCSP.isNodeReadyResult = true
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0))) // Should not requeue on completion
+
+			// Verify no additional reboot signals were sent
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			// Get updated RebootNode
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Verify completion
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(nodeReadyCondition.Reason).To(Equal("Succeeded"))
+		})
+
+		It("should continue monitoring when node is not ready", func() {
+			// Set mock to return node as not ready
+			mockCSP.isNodeReadyResult = false
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second)) // Should requeue for monitoring
+
+			// Verify no additional reboot signals were sent
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			// Get updated RebootNode
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Verify still in progress
+			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
+			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+		})
+
+		It("should keep monitoring until the CSP reboot operation completes", func() {
+			mockCSP.rebootIncomplete = true
+			mockCSP.isNodeReadyResult = true
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+			// Node health is not checked before the operation is done
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(0))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
+
+			// Once the operation completes the node health gate decides
+			mockCSP.rebootIncomplete = false
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(1))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should keep monitoring when the operation is complete but the node is not ready", func() {
+			mockCSP.isNodeReadyResult = false
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(1))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
+		})
+
+		It("should fail when the CSP reboot operation cannot be checked", func() {
+			mockCSP.isRebootCompleteError = errors.New("operation lookup failed")
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(0))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReadyCondition.Message).To(ContainSubstring("IsRebootComplete failed for node test-node"))
+		})
+
+		It("should check the node directly when the CSP reboot operation reference expired", func() {
+			mockCSP.isRebootCompleteError = fmt.Errorf("%w: operation-123", model.ErrCSPRequestExpired)
+			mockCSP.isNodeReadyResult = false
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(1))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
+
+			// The reboot succeeds once the instance is back, although the reference stays expired
+			mockCSP.isNodeReadyResult = true
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+			Expect(updatedRebootNode.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should remove the trigger taint before completing the reboot", func() {
+			const triggerTaint = "nvsentinel.dgxc.nvidia.com/reboot-required"
+
+			mockCSP.isNodeReadyResult = true
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Spec.Taints = []corev1.Taint{{Key: triggerTaint, Effect: corev1.TaintEffectNoSchedule}}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			rebootNode.Annotations = map[string]string{RemoveTaintAnnotation: triggerTaint}
+			Expect(k8sClient.Update(ctx, &rebootNode)).To(Succeed())
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Second))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Spec.Taints).To(BeEmpty())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			Expect(rebootNode.Status.CompletionTime).To(BeNil())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			Expect(rebootNode.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should not count the taint removal towards the retry limit", func() {
+			const triggerTaint = "nvsentinel.dgxc.nvidia.com/reboot-required"
+
+			mockCSP.isNodeReadyResult = true
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Spec.Taints = []corev1.Taint{{Key: triggerTaint, Effect: corev1.TaintEffectNoSchedule}}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			rebootNode.Annotations = map[string]string{RemoveTaintAnnotation: triggerTaint}
+			Expect(k8sClient.Update(ctx, &rebootNode)).To(Succeed())
+
+			// The last retry finds the node ready and removes the taint
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+			rebootNode.Status.RetryCount = maxRetries - 1
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			Expect(rebootNode.Status.RetryCount).To(Equal(maxRetries - 1))
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			Expect(rebootNode.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should partition the action metrics by the allow-listed node pool label", func() {
+			const poolLabel = "example.com/node-pool"
+
+			metrics.GlobalMetrics.SetNodePoolLabel(poolLabel)
+			DeferCleanup(metrics.GlobalMetrics.SetNodePoolLabel, "")
+
+			mockCSP.isNodeReadyResult = true
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Labels = map[string]string{poolLabel: "h100-a", "example.com/rack": "r12"}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			families, err := ctrlmetrics.Registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+
+			var pools []string
+
+			for _, family := range families {
+				if family.GetName() != "janitor_actions_count" {
+					continue
+				}
+
+				for _, metric := range family.GetMetric() {
+					labels := map[string]string{}
+					for _, label := range metric.GetLabel() {
+						labels[label.GetName()] = label.GetValue()
+					}
+
+					if labels["node"] == testNode.Name && labels["status"] == metrics.StatusSucceeded {
+						pools = append(pools, labels["node_pool"])
+						Expect(labels).NotTo(HaveKey("example.com/rack"))
+					}
+				}
+			}
+
+			Expect(pools).To(ContainElement("h100-a"))
+		})
+
+		It("should count successful reboots on the node", func() {
+			mockCSP.isNodeReadyResult = true
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Annotations = map[string]string{DefaultRebootCountAnnotation: "2"}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue(DefaultRebootCountAnnotation, "3"))
+
+			// The completed RebootNode is not counted again
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue(DefaultRebootCountAnnotation, "3"))
+		})
+
+		It("should not count failed reboots on the node", func() {
+			mockCSP.isNodeReadyResult = false
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Annotations = map[string]string{
+				DefaultRebootCountAnnotation: "2",
+				RebootTimeoutAnnotation:      "1m",
+			}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.IsFailed()).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue(DefaultRebootCountAnnotation, "2"))
+		})
+
+		It("should rely on the Kubernetes node status when the node readiness reference expired", func() {
+			mockCSP.isNodeReadyError = fmt.Errorf("%w: operation-123", model.ErrCSPRequestExpired)
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.IsSucceeded()).To(BeTrue())
+		})
+
+		Context("when a readiness mode is configured", func() {
+			var req reconcile.Request
+
+			setKubernetesReady := func(status corev1.ConditionStatus) {
+				var node corev1.Node
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+				node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}
+				Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+			}
+
+			isSucceeded := func() bool {
+				var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+
+				return updatedRebootNode.IsSucceeded()
+			}
+
+			BeforeEach(func() {
+				req = reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+			})
+
+			It("should require both reports in both mode", func() {
+				reconciler.Config.ReadinessMode = config.ReadinessModeBoth
+				mockCSP.isNodeReadyResult = true
+				setKubernetesReady(corev1.ConditionFalse)
+
+				_, err := reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isSucceeded()).To(BeFalse())
+
+				setKubernetesReady(corev1.ConditionTrue)
+
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isSucceeded()).To(BeTrue())
+			})
+
+			It("should succeed on the CSP report alone in csp-only mode", func() {
+				reconciler.Config.ReadinessMode = config.ReadinessModeCSPOnly
+				mockCSP.isNodeReadyResult = true
+				setKubernetesReady(corev1.ConditionFalse)
+
+				_, err := reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isSucceeded()).To(BeTrue())
+			})
+
+			It("should succeed on the Kubernetes report alone in k8s-only mode", func() {
+				reconciler.Config.ReadinessMode = config.ReadinessModeKubernetesOnly
+				mockCSP.isNodeReadyResult = false
+				setKubernetesReady(corev1.ConditionTrue)
+
+				_, err := reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isSucceeded()).To(BeTrue())
+				Expect(mockCSP.isNodeReadyCalled).To(Equal(0))
+			})
+		})
+
+		It("should timeout after configured duration", func() {
+			// Set start time to be past the timeout
+			pastTime := time.Now().Add(-35 * time.Minute) // Past 30 minute timeout
+			testRebootNode.Status.StartTime = &metav1.Time{Time: pastTime}
+			err := k8sClient.Status().Update(ctx, testRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Set mock to return node as not ready
+			mockCSP.isNodeReadyResult = false
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0))) // Should not requeue on timeout
+
+			// Get updated RebootNode
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Verify timeout
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReadyCondition.Reason).To(Equal("Timeout"))
+		})
+	})
+
+	Context("when hard reboot escalation is enabled", func() {
+		BeforeEach(func() {
+			reconciler.Config.EscalateToHardReboot = true
+
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "soft-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			mockCSP.isNodeReadyResult = false
+			mockCSP.sendRebootSignalResult = model.ResetSignalRequestRef("hard-request-ref")
+		})
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		expectEscalated := func(updated janitordgxcnvidiacomv1alpha1.RebootNode) {
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(mockCSP.sendRebootSignalOpts.RebootType).To(Equal(model.RebootTypeHard))
+
+			escalated := findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot)
+			Expect(escalated).NotTo(BeNil())
+			Expect(escalated.Status).To(Equal(metav1.ConditionTrue))
+			Expect(updated.GetCSPReqRef()).To(Equal("hard-request-ref"))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(time.Since(updated.Status.StartTime.Time)).To(BeNumerically("<", time.Minute))
+		}
+
+		It("should escalate once and complete when the node returns", func() {
+			expectEscalated(reconcileAndGet())
+
+			mockCSP.isNodeReadyResult = true
+			updated := reconcileAndGet()
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+
+		It("should fail without escalating again when the hard reboot also times out", func() {
+			updated := reconcileAndGet()
+			expectEscalated(updated)
+
+			updated.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+
+			updated = reconcileAndGet()
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReady.Reason).To(Equal("Timeout"))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+
+		It("should fail as timed out when the hard reboot cannot be sent", func() {
+			mockCSP.sendRebootSignalError = errors.New("csp unavailable")
+
+			updated := reconcileAndGet()
+
+			escalated := findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot)
+			Expect(escalated).NotTo(BeNil())
+			Expect(escalated.Status).To(Equal(metav1.ConditionFalse))
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Reason).To(Equal("Timeout"))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+		})
+
+		It("should not escalate a hard reboot", func() {
+			testRebootNode.Spec.RebootType = janitordgxcnvidiacomv1alpha1.RebootTypeHard
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+
+			updated := reconcileAndGet()
+
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionEscalatedToHardReboot)).To(BeNil())
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+		})
+	})
+
+	Context("when GPU readiness is required", func() {
+		BeforeEach(func() {
+			reconciler.Config.GPUReadiness.Enabled = true
+			reconciler.Config.GPUReadiness.Timeout = 10 * time.Minute
+
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			mockCSP.isNodeReadyResult = true
+		})
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("should wait for the GPUs and complete once they are allocatable", func() {
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			gpuReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady)
+			Expect(gpuReady).NotTo(BeNil())
+			Expect(gpuReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(gpuReady.Reason).To(Equal("WaitingForGPUs"))
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.Allocatable = corev1.ResourceList{GPUResourceName: resource.MustParse("8")}
+			Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+
+			updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			gpuReady = findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady)
+			Expect(gpuReady.Status).To(Equal(metav1.ConditionTrue))
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should not count the GPU wait towards the retry limit", func() {
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+
+			for range maxRetries + 2 {
+				updated := reconcileAndGet()
+				Expect(updated.Status.CompletionTime).To(BeNil())
+				Expect(updated.Status.RetryCount).To(BeZero())
+
+				gpuReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady)
+				Expect(gpuReady.Reason).To(Equal("WaitingForGPUs"))
+			}
+		})
+
+		It("should accept the driver installed label as GPU readiness", func() {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Labels = map[string]string{DriverInstalledLabel: "true"}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should fail when the GPUs do not become available within the timeout", func() {
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			// Pretend the wait started longer ago than the GPU readiness timeout
+			for i := range updated.Status.Conditions {
+				if updated.Status.Conditions[i].Type == janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady {
+					updated.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+				}
+			}
+			updated.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+			Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+
+			updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			gpuReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionGPUReady)
+			Expect(gpuReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(gpuReady.Reason).To(Equal("Timeout"))
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReady.Reason).To(Equal("GPUsNotReady"))
+		})
+	})
+
+	Context("when a pre-reboot job is configured", func() {
+		var jobKey types.NamespacedName
+
+		BeforeEach(func() {
+			reconciler.Config.Hooks.Namespace = "nvsentinel"
+
+			testRebootNode.Spec.PreRebootJobTemplate = &batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "flush", Image: "busybox"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+
+			jobKey = types.NamespacedName{Namespace: "nvsentinel", Name: testRebootNode.Name + "-pre-reboot"}
+		})
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("should reboot only after the job succeeds", func() {
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			var job batchv1.Job
+			Expect(k8sClient.Get(ctx, jobKey, &job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(HookJobNodeAnnotation, testNode.Name))
+			Expect(job.OwnerReferences).To(HaveLen(1))
+			Expect(job.OwnerReferences[0].Name).To(Equal(testRebootNode.Name))
+
+			preReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot).NotTo(BeNil())
+			Expect(preReboot.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobRunning))
+
+			// Still running
+			reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			finishJob(ctx, k8sClient, jobKey, batchv1.JobComplete)
+
+			updated = reconcileAndGet()
+			preReboot = findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot.Status).To(Equal(metav1.ConditionTrue))
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobSucceeded))
+
+			updated = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(updated.IsRebootInProgress()).To(BeTrue())
+		})
+
+		It("should not reboot when the job fails", func() {
+			reconcileAndGet()
+			finishJob(ctx, k8sClient, jobKey, batchv1.JobFailed)
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			preReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot.Status).To(Equal(metav1.ConditionFalse))
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobFailed))
+			Expect(preReboot.Message).To(ContainSubstring("job finished"))
+
+			signalSent := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+			Expect(signalSent.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should not reboot and delete the job when it times out", func() {
+			updated := reconcileAndGet()
+
+			// Pretend the job was started longer ago than the hook job timeout
+			for i := range updated.Status.Conditions {
+				if updated.Status.Conditions[i].Type == janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob {
+					updated.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+				}
+			}
+			Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+
+			updated = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			preReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobFailed))
+
+			var job batchv1.Job
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, jobKey, &job))).To(BeTrue())
+		})
+
+		It("should not reboot nor create the job when the template sets another namespace", func() {
+			testRebootNode.Spec.PreRebootJobTemplate.Namespace = "kube-system"
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			preReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobFailed))
+			Expect(preReboot.Message).To(ContainSubstring("kube-system"))
+
+			var jobs batchv1.JobList
+			Expect(k8sClient.List(ctx, &jobs)).To(Succeed())
+			Expect(jobs.Items).To(BeEmpty())
+		})
+
+		It("should neither adopt nor delete a job of the same name it does not own", func() {
+			foreign := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: jobKey.Namespace, Name: jobKey.Name},
+				Spec:       *testRebootNode.Spec.PreRebootJobTemplate.Spec.DeepCopy(),
+			}
+			Expect(k8sClient.Create(ctx, foreign)).To(Succeed())
+			finishJob(ctx, k8sClient, jobKey, batchv1.JobComplete)
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			preReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob)
+			Expect(preReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PreRebootJobFailed))
+			Expect(preReboot.Message).To(ContainSubstring("not owned"))
+
+			var job batchv1.Job
+			Expect(k8sClient.Get(ctx, jobKey, &job)).To(Succeed())
+			Expect(job.OwnerReferences).To(BeEmpty())
+		})
+	})
+
+	Context("when a post-reboot verification job is configured", func() {
+		var jobKey types.NamespacedName
+
+		BeforeEach(func() {
+			reconciler.Config.Hooks.Namespace = "nvsentinel"
+
+			testRebootNode.Spec.PostRebootJobTemplate = &batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "burn-in", Image: "gpu-burn"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			mockCSP.isNodeReadyResult = true
+			jobKey = types.NamespacedName{Namespace: "nvsentinel", Name: testRebootNode.Name + "-post-reboot"}
+		})
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("should succeed only after the verification job succeeds", func() {
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			var job batchv1.Job
+			Expect(k8sClient.Get(ctx, jobKey, &job)).To(Succeed())
+
+			postReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob)
+			Expect(postReboot).NotTo(BeNil())
+			Expect(postReboot.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(postReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PostRebootJobRunning))
+
+			finishJob(ctx, k8sClient, jobKey, batchv1.JobComplete)
+
+			updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			postReboot = findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob)
+			Expect(postReboot.Status).To(Equal(metav1.ConditionTrue))
+
+			updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should not count the verification job wait towards the retry limit", func() {
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+
+			for range maxRetries + 2 {
+				updated := reconcileAndGet()
+				Expect(updated.Status.CompletionTime).To(BeNil())
+				Expect(updated.Status.RetryCount).To(BeZero())
+
+				postReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob)
+				Expect(postReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PostRebootJobRunning))
+			}
+		})
+
+		It("should fail the reboot when the verification job fails", func() {
+			reconcileAndGet()
+			finishJob(ctx, k8sClient, jobKey, batchv1.JobFailed)
+
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			postReboot := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPostRebootJob)
+			Expect(postReboot.Status).To(Equal(metav1.ConditionFalse))
+			Expect(postReboot.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.PostRebootJobFailed))
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReady.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.VerificationJobFailed))
+		})
+	})
+
+	Context("when the RebootNode is paused by annotation", func() {
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		setPaused := func(paused bool) {
+			var current janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &current)).To(Succeed())
+
+			if paused {
+				current.Annotations = map[string]string{PauseAnnotation: "true"}
+			} else {
+				delete(current.Annotations, PauseAnnotation)
+			}
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+		}
+
+		It("should not send the reboot signal until resumed", func() {
+			setPaused(true)
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			paused := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused)
+			Expect(paused).NotTo(BeNil())
+			Expect(paused.Status).To(Equal(metav1.ConditionTrue))
+
+			reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			setPaused(false)
+
+			updated = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			paused = findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused)
+			Expect(paused.Status).To(Equal(metav1.ConditionFalse))
+			Expect(paused.Reason).To(Equal("Resumed"))
+		})
+
+		It("should stop writing the status after the first reconcile", func() {
+			setPaused(true)
+
+			updated := reconcileAndGet()
+			Expect(updated.Status.NextAttemptTime).NotTo(BeNil())
+
+			// Pretend the reconcile triggered by the status write runs a few seconds later
+			scheduled := metav1.NewTime(updated.Status.NextAttemptTime.Add(-5 * time.Second))
+			updated.Status.NextAttemptTime = &scheduled
+			Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+
+			again := reconcileAndGet()
+			Expect(again.ResourceVersion).To(Equal(updated.ResourceVersion))
+			Expect(again.Status.NextAttemptTime.Time).To(BeTemporally("==", scheduled.Time))
+		})
+
+		It("should not advance an in-flight reboot and exclude the pause from the timeout", func() {
+			startTime := metav1.NewTime(time.Now().Add(-20 * time.Minute).Truncate(time.Second))
+			testRebootNode.Status.StartTime = &startTime
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionPaused,
+					Status:             metav1.ConditionTrue,
+					Reason:             "PausedByAnnotation",
+					Message:            "RebootNode is annotated with " + PauseAnnotation,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-15 * time.Minute)),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+			setPaused(true)
+
+			mockCSP.isNodeReadyResult = true
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(0))
+			Expect(updated.Status.RetryCount).To(BeZero())
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			// Resuming moves the start time forward by the 15 minutes spent paused
+			mockCSP.isNodeReadyResult = false
+			setPaused(false)
+
+			updated = reconcileAndGet()
+			Expect(mockCSP.isNodeReadyCalled).To(Equal(1))
+			Expect(updated.Status.StartTime.Time).To(BeTemporally("~", startTime.Add(15*time.Minute), 5*time.Second))
+		})
+	})
+
+	Context("when the cluster is degraded", func() {
+		createNodes := func(status corev1.ConditionStatus, names ...string) {
+			for _, name := range names {
+				Expect(k8sClient.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+					},
+				})).To(Succeed())
+			}
+		}
+
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.DegradedCluster.UnknownNodesPercent = 50
+
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-35 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			testNode.Status.Conditions[0].Status = corev1.ConditionUnknown
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+		})
+
+		It("should hold a timed out reboot while most nodes report Unknown", func() {
+			createNodes(corev1.ConditionUnknown, "other-node-1", "other-node-2")
+			createNodes(corev1.ConditionTrue, "other-node-3")
+
+			result, updated := reconcileAndGet()
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(nodeReady.Reason).To(Equal("ClusterDegraded"))
+
+			// The node comes back once the control plane recovers
+			testNode.Status.Conditions[0].Status = corev1.ConditionTrue
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+			mockCSP.isNodeReadyResult = true
+
+			_, updated = reconcileAndGet()
+			Expect(updated.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should keep holding past the retry limit", func() {
+			createNodes(corev1.ConditionUnknown, "other-node-1", "other-node-2", "other-node-3")
+
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+			testRebootNode.Status.RetryCount = maxRetries - 1
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			for range maxRetries + 2 {
+				_, updated := reconcileAndGet()
+				Expect(updated.Status.CompletionTime).To(BeNil())
+				Expect(updated.Status.RetryCount).To(Equal(maxRetries - 1))
+
+				nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+				Expect(nodeReady.Reason).To(Equal("ClusterDegraded"))
+			}
+		})
+
+		It("should fail a timed out reboot when only a few nodes report Unknown", func() {
+			createNodes(corev1.ConditionUnknown, "other-node-1")
+			createNodes(corev1.ConditionTrue, "other-node-2", "other-node-3")
+
+			_, updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(updated.FailureReason()).To(Equal("Timeout"))
+		})
+
+		It("should fail a timed out reboot when the rebooted node is reachable but NotReady", func() {
+			testNode.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+			createNodes(corev1.ConditionUnknown, "other-node-1", "other-node-2", "other-node-3")
+
+			_, updated := reconcileAndGet()
+			Expect(updated.FailureReason()).To(Equal("Timeout"))
+		})
+
+		It("should not hold when the hold is disabled", func() {
+			reconciler.Config.DegradedCluster.UnknownNodesPercent = 0
+			createNodes(corev1.ConditionUnknown, "other-node-1", "other-node-2", "other-node-3")
+
+			_, updated := reconcileAndGet()
+			Expect(updated.FailureReason()).To(Equal("Timeout"))
+		})
+	})
+
+	Context("when concurrent reboots are limited", func() {
+		createRebootNode := func(name string, priority int32, conditions ...metav1.Condition) {
+			Expect(k8sClient.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-node"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			})).To(Succeed())
+
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{
+					NodeName: name + "-node",
+					Priority: priority,
+				},
+			}
+			Expect(k8sClient.Create(ctx, rebootNode)).To(Succeed())
+
+			if len(conditions) > 0 {
+				rebootNode.Status.StartTime = &metav1.Time{Time: time.Now()}
+				rebootNode.Status.Conditions = conditions
+				Expect(k8sClient.Status().Update(ctx, rebootNode)).To(Succeed())
+			}
+		}
+
+		reconcileAndGet := func(name string) (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		complete := func(name string) {
+			var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name}, &rebootNode)).To(Succeed())
+
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, &rebootNode)).To(Succeed())
+		}
+
+		// admissionOrder reconciles the unfinished RebootNodes until all of them were signaled, completing
+		// the one holding the only reboot slot each round, and returns the order they were signaled in
+		admissionOrder := func(names ...string) []string {
+			var order []string
+
+			for range names {
+				for _, name := range names {
+					if slices.Contains(order, name) {
+						continue
+					}
+
+					_, updated := reconcileAndGet(name)
+					if meta.IsStatusConditionTrue(updated.Status.Conditions,
+						janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent) {
+						order = append(order, name)
+						complete(name)
+					}
+				}
+			}
+
+			return order
+		}
+
+		signalSent := metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Succeeded",
+			Message:            "test-request-ref",
+			LastTransitionTime: metav1.Now(),
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.Admission.MaxConcurrentReboots = 1
+		})
+
+		It("should wait for a reboot slot and restart the timeout once admitted", func() {
+			createRebootNode("in-flight", 0, signalSent)
+
+			result, updated := reconcileAndGet(testRebootNode.Name)
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			waiting := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+			Expect(waiting.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(waiting.Reason).To(Equal(waitingForRebootSlotReason))
+
+			// Waiting does not count against the reboot timeout
+			updated.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+
+			complete("in-flight")
+
+			_, updated = reconcileAndGet(testRebootNode.Name)
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(time.Since(updated.Status.StartTime.Time)).To(BeNumerically("<", time.Minute))
+		})
+
+		It("should count a running pre-reboot Job against the limit", func() {
+			createRebootNode("in-flight", 0, metav1.Condition{
+				Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionPreRebootJob,
+				Status:             metav1.ConditionUnknown,
+				Reason:             janitordgxcnvidiacomv1alpha1.PreRebootJobRunning,
+				Message:            "Waiting for the pre-reboot Job",
+				LastTransitionTime: metav1.Now(),
+			})
+
+			_, _ = reconcileAndGet(testRebootNode.Name)
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+		})
+
+		It("should admit the highest priority first by default", func() {
+			testRebootNode.Spec.Priority = 1
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+			createRebootNode("low", 0)
+			createRebootNode("high", 10)
+			createRebootNode("mid", 5)
+
+			Expect(admissionOrder(testRebootNode.Name, "low", "high", "mid")).
+				To(Equal([]string{"high", "mid", testRebootNode.Name, "low"}))
+		})
+
+		It("should admit the lowest priority first when configured", func() {
+			reconciler.Config.Admission.PriorityOrder = config.PriorityOrderLowestFirst
+			testRebootNode.Spec.Priority = 1
+			Expect(k8sClient.Update(ctx, testRebootNode)).To(Succeed())
+			createRebootNode("low", 0)
+			createRebootNode("high", 10)
+			createRebootNode("mid", 5)
+
+			Expect(admissionOrder(testRebootNode.Name, "low", "high", "mid")).
+				To(Equal([]string{"low", testRebootNode.Name, "mid", "high"}))
+		})
+
+		It("should not limit reboots when the limit is disabled", func() {
+			reconciler.Config.Admission.MaxConcurrentReboots = 0
+			createRebootNode("in-flight", 0, signalSent)
+
+			_, _ = reconcileAndGet(testRebootNode.Name)
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+	})
+
+	Context("when a reboot budget is configured", func() {
+		// createRebootedNode creates a completed RebootNode whose reboot started the given time ago
+		createRebootedNode := func(name string, startedAgo time.Duration) {
+			rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: name + "-node"},
+			}
+			Expect(k8sClient.Create(ctx, rebootNode)).To(Succeed())
+
+			rebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-startedAgo)}
+			rebootNode.Status.Conditions = []metav1.Condition{{
+				Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+				Status:             metav1.ConditionTrue,
+				Reason:             "Succeeded",
+				Message:            "test-request-ref",
+				LastTransitionTime: metav1.Now(),
+			}}
+			rebootNode.SetCompletionTime()
+			Expect(k8sClient.Status().Update(ctx, rebootNode)).To(Succeed())
+		}
+
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.Budget = config.RebootBudgetConfig{MaxReboots: 2, Window: time.Hour}
+		})
+
+		It("should reboot while the budget is not spent", func() {
+			createRebootedNode("earlier", 10*time.Minute)
+
+			_, updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted)).To(BeNil())
+		})
+
+		It("should defer the reboot beyond the budget until the window rolls", func() {
+			createRebootedNode("older", 50*time.Minute)
+			createRebootedNode("newer", 10*time.Minute)
+			createRebootedNode("outside-window", 2*time.Hour)
+
+			result, updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(result.RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Minute))
+
+			exhausted := findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted)
+			Expect(exhausted).NotTo(BeNil())
+			Expect(exhausted.Status).To(Equal(metav1.ConditionTrue))
+
+			// The window rolls past the older reboot
+			var older janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "older"}, &older)).To(Succeed())
+			older.Status.StartTime = &metav1.Time{Time: time.Now().Add(-61 * time.Minute)}
+			Expect(k8sClient.Status().Update(ctx, &older)).To(Succeed())
+
+			_, updated = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			exhausted = findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionBudgetExhausted)
+			Expect(exhausted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(time.Since(updated.Status.StartTime.Time)).To(BeNumerically("<", time.Minute))
+		})
+
+		It("should not count reboots whose signal was never sent", func() {
+			createRebootedNode("earlier", 10*time.Minute)
+			Expect(k8sClient.Create(ctx, &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "pending"},
+				Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "pending-node"},
+				Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+					StartTime: &metav1.Time{Time: time.Now()},
+				},
+			})).To(Succeed())
+
+			_, _ = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+	})
+
+	Context("when spare capacity is required", func() {
+		// createGPUNode creates a node advertising the given number of allocatable GPUs
+		createGPUNode := func(name string, gpus string, ready corev1.ConditionStatus) {
+			Expect(k8sClient.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{GPUResourceName: resource.MustParse(gpus)},
+					Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+				},
+			})).To(Succeed())
+		}
+
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.SpareCapacity = config.SpareCapacityConfig{MinSpareGPUs: 16}
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.Allocatable = corev1.ResourceList{GPUResourceName: resource.MustParse("8")}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+		})
+
+		It("should reboot when the other ready nodes have enough GPUs", func() {
+			createGPUNode("spare-1", "8", corev1.ConditionTrue)
+			createGPUNode("spare-2", "8", corev1.ConditionTrue)
+
+			_, updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity)).To(BeNil())
+		})
+
+		It("should defer the reboot until the other ready nodes have enough GPUs", func() {
+			// The target's own GPUs and those of a node that is not ready do not count
+			createGPUNode("spare-1", "8", corev1.ConditionTrue)
+			createGPUNode("not-ready", "8", corev1.ConditionFalse)
+
+			result, updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			insufficient := findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity)
+			Expect(insufficient).NotTo(BeNil())
+			Expect(insufficient.Status).To(Equal(metav1.ConditionTrue))
+
+			// Another node joins the cluster
+			createGPUNode("spare-2", "8", corev1.ConditionTrue)
+
+			_, updated = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			insufficient = findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity)
+			Expect(insufficient.Status).To(Equal(metav1.ConditionFalse))
+		})
+	})
+
+	Context("when the status is written with server-side apply", func() {
+		BeforeEach(func() {
+			reconciler.Config.ServerSideApplyStatus = true
+			mockCSP.isNodeReadyResult = true
+
+			// Start from fresh objects so managed fields are only those of the applies
+			testNode.ResourceVersion = ""
+			testRebootNode.ResourceVersion = ""
+
+			k8sClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testNode, testRebootNode).
+				WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+				WithReturnManagedFields().
+				Build()
+			reconciler.Client = k8sClient
+		})
+
+		It("should apply the status with the janitor field manager", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+			Expect(updated.Status.StartTime).NotTo(BeNil())
+
+			signalSent := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+			Expect(signalSent).NotTo(BeNil())
+			Expect(signalSent.Status).To(Equal(metav1.ConditionTrue))
+
+			Expect(updated.ManagedFields).To(ContainElement(SatisfyAll(
+				HaveField("Manager", StatusFieldManager),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+
+			// A later apply clears the fields it no longer sets
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(updated.Status.NextAttemptTime).To(BeNil())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
+	Context("when the node is read through an injected getter", func() {
+		var (
+			getter *stubNodeGetter
+			req    reconcile.Request
+		)
+
+		nodeWithReady := func(status corev1.ConditionStatus) *corev1.Node {
+			node := testNode.DeepCopy()
+			node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}
+
+			return node
+		}
+
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		BeforeEach(func() {
+			getter = &stubNodeGetter{}
+			reconciler.NodeGetter = getter
+			mockCSP.isNodeReadyResult = true
+			req = reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			// The reboot signal was already sent
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{{
+				Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+				Status:             metav1.ConditionTrue,
+				Reason:             "Succeeded",
+				Message:            "test-request-ref",
+				LastTransitionTime: metav1.Now(),
+			}}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+		})
+
+		It("should succeed when the node is ready", func() {
+			getter.node = nodeWithReady(corev1.ConditionTrue)
+
+			result, updated := reconcileAndGet()
+			Expect(getter.calls).To(Equal(1))
+			Expect(result.RequeueAfter).To(BeZero())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should keep waiting while the node is not ready", func() {
+			getter.node = nodeWithReady(corev1.ConditionFalse)
+
+			result, updated := reconcileAndGet()
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+		})
+
+		It("should time out when the node is still not ready after the timeout", func() {
+			getter.node = nodeWithReady(corev1.ConditionFalse)
+
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-reconciler.Config.Timeout - time.Minute)}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			result, updated := reconcileAndGet()
+			Expect(result.RequeueAfter).To(BeZero())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionFalse))
+			Expect(nodeReady.Reason).To(Equal("Timeout"))
+		})
+
+		It("should stop reconciling when the node is not found", func() {
+			getter.err = apierrors.NewNotFound(corev1.Resource("nodes"), testNode.Name)
+
+			result, updated := reconcileAndGet()
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(mockCSP.isNodeReadyCalled).To(BeZero())
+		})
+
+		It("should return errors other than not found", func() {
+			getter.err = errors.New("api server unavailable")
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).To(MatchError("api server unavailable"))
+		})
+	})
+
+	Context("when the controller restarts during a failure backoff", func() {
+		// seedBackoff records a backoff as a previous controller instance would have left it
+		seedBackoff := func(consecutiveFailures int32, nextAttemptIn time.Duration) {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, testRebootNode)).To(Succeed())
+
+			testRebootNode.SetInitialConditions()
+			testRebootNode.SetStartTime()
+			testRebootNode.Status.ConsecutiveFailures = consecutiveFailures
+			testRebootNode.Status.NextAttemptTime = &metav1.Time{Time: time.Now().Add(nextAttemptIn)}
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+		}
+
+		// restartedReconcile reconciles with a new reconciler, which has no in-memory requeue state
+		restartedReconcile := func() ctrl.Result {
+			restarted := &RebootNodeReconciler{
+				Client:    k8sClient,
+				Scheme:    reconciler.Scheme,
+				CSPClient: mockCSP,
+				Config:    reconciler.Config,
+			}
+
+			result, err := restarted.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return result
+		}
+
+		It("should requeue for the remaining backoff instead of acting", func() {
+			seedBackoff(3, 4*time.Minute)
+
+			result := restartedReconcile()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(result.RequeueAfter).To(BeNumerically("~", 4*time.Minute, 5*time.Second))
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+			Expect(updated.Status.NextAttemptTime).NotTo(BeNil())
+			Expect(updated.Status.ConsecutiveFailures).To(Equal(int32(3)))
+		})
+
+		It("should act once the backoff has elapsed", func() {
+			seedBackoff(3, -time.Second)
+
+			_ = restartedReconcile()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+
+		It("should not wait for a scheduled poll without failures", func() {
+			seedBackoff(0, 4*time.Minute)
+
+			_ = restartedReconcile()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+	})
+
+	Context("when post-success stability is verified", func() {
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		// elapseVerifyDelay moves the start of the stability verification past the verify delay
+		elapseVerifyDelay := func(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) {
+			for i := range rebootNode.Status.Conditions {
+				if rebootNode.Status.Conditions[i].Type == janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady {
+					rebootNode.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+				}
+			}
+
+			rebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			Expect(k8sClient.Status().Update(ctx, rebootNode)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.PostSuccessVerifyDelay = time.Minute
+
+			_, _ = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			mockCSP.isNodeReadyResult = true
+		})
+
+		It("should check readiness once more after the delay before completing", func() {
+			result, updated := reconcileAndGet()
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			nodeReady := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReady.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(nodeReady.Reason).To(Equal(verifyingStabilityReason))
+
+			// A reconcile before the delay elapsed keeps waiting
+			result, updated = reconcileAndGet()
+			Expect(result.RequeueAfter).To(BeNumerically("<=", time.Minute))
+			Expect(updated.Status.CompletionTime).To(BeNil())
+
+			elapseVerifyDelay(&updated)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should not count the verification towards the retry limit", func() {
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+
+			for range maxRetries + 2 {
+				_, updated := reconcileAndGet()
+				Expect(updated.Status.CompletionTime).To(BeNil())
+				Expect(updated.Status.RetryCount).To(BeZero())
+				Expect(isVerifyingStability(&updated)).To(BeTrue())
+			}
+		})
+
+		It("should fail the reboot when the node flapped back to NotReady", func() {
+			_, updated := reconcileAndGet()
+			Expect(isVerifyingStability(&updated)).To(BeTrue())
+
+			testNode.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+			elapseVerifyDelay(&updated)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(updated.IsFailed()).To(BeTrue())
+			Expect(updated.FailureReason()).To(Equal("NodeFlapped"))
+		})
+
+		It("should complete immediately when the verification is disabled", func() {
+			reconciler.Config.PostSuccessVerifyDelay = 0
+
+			_, updated := reconcileAndGet()
+			Expect(updated.IsSucceeded()).To(BeTrue())
+		})
+	})
+
+	Context("when a ready stability window is configured", func() {
+		reconcileAndGet := func() (ctrl.Result, janitordgxcnvidiacomv1alpha1.RebootNode) {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return result, updated
+		}
+
+		setNodeReady := func(status corev1.ConditionStatus) {
+			testNode.Status.Conditions[0].Status = status
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+		}
+
+		// backdateStatus moves a status timestamp of the RebootNode into the past
+		backdateStatus := func(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, field **metav1.Time, ago time.Duration) {
+			*field = &metav1.Time{Time: time.Now().Add(-ago)}
+			Expect(k8sClient.Status().Update(ctx, rebootNode)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.ReadyStabilityWindow = 2 * time.Minute
+
+			_, _ = reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			mockCSP.isNodeReadyResult = true
+		})
+
+		It("should not count the window towards the retry limit", func() {
+			maxRetries := reconciler.getMaxRetriesForNode(ctx, testNode)
+
+			for range maxRetries + 2 {
+				_, updated := reconcileAndGet()
+				Expect(updated.Status.CompletionTime).To(BeNil())
+				Expect(updated.Status.RetryCount).To(BeZero())
+				Expect(updated.Status.ReadySince).NotTo(BeNil())
+			}
+		})
+
+		It("should not record the ready time when the window is disabled", func() {
+			reconciler.Config.ReadyStabilityWindow = 0
+
+			_, updated := reconcileAndGet()
+			Expect(updated.IsSucceeded()).To(BeTrue())
+			Expect(updated.Status.ReadySince).To(BeNil())
+		})
+
+		It("should succeed once a node that flapped stays ready for the window", func() {
+			result, updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(updated.Status.ReadySince).NotTo(BeNil())
+			Expect(result.RequeueAfter).To(BeNumerically("<=", 30*time.Second))
+
+			// The node flaps while the kubelet stabilizes, restarting the window
+			setNodeReady(corev1.ConditionFalse)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(updated.Status.ReadySince).To(BeNil())
+
+			setNodeReady(corev1.ConditionTrue)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(updated.Status.ReadySince).NotTo(BeNil())
+
+			backdateStatus(&updated, &updated.Status.ReadySince, 2*time.Minute+time.Second)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.IsSucceeded()).To(BeTrue())
+		})
+
+		It("should keep waiting and time out when the node keeps flapping past the window", func() {
+			_, updated := reconcileAndGet()
+			Expect(updated.Status.ReadySince).NotTo(BeNil())
+
+			// The node was ready longer than the window, but not in every check
+			backdateStatus(&updated, &updated.Status.ReadySince, 4*time.Minute)
+			setNodeReady(corev1.ConditionFalse)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(updated.Status.ReadySince).To(BeNil())
+
+			backdateStatus(&updated, &updated.Status.StartTime, 31*time.Minute)
+
+			_, updated = reconcileAndGet()
+			Expect(updated.IsFailed()).To(BeTrue())
+			Expect(updated.FailureReason()).To(Equal("Timeout"))
+		})
+	})
+
+	Context("when the reboot lifecycle is reported on the node", func() {
+		reconcileOnce := func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		nodeCondition := func(conditionType string) *corev1.NodeCondition {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			for i := range node.Status.Conditions {
+				if string(node.Status.Conditions[i].Type) == conditionType {
+					return &node.Status.Conditions[i]
+				}
+			}
+
+			return nil
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.NodeCondition.Enabled = true
+		})
+
+		It("should set the condition on signal and clear it once the reboot succeeds", func() {
+			reconcileOnce()
+
+			condition := nodeCondition(DefaultRebootNodeConditionType)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(NodeConditionReasonInProgress))
+			Expect(condition.Message).To(ContainSubstring(testRebootNode.Name))
+
+			// The node keeps its Ready condition
+			Expect(nodeCondition(string(corev1.NodeReady))).NotTo(BeNil())
+
+			mockCSP.isNodeReadyResult = true
+			reconcileOnce()
+
+			condition = nodeCondition(DefaultRebootNodeConditionType)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(NodeConditionReasonCompleted))
+		})
+
+		It("should report a timed out reboot as failed", func() {
+			reconcileOnce()
+
+			var current janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &current)).To(Succeed())
+			current.Status.StartTime = &metav1.Time{Time: time.Now().Add(-35 * time.Minute)}
+			Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+
+			reconcileOnce()
+
+			condition := nodeCondition(DefaultRebootNodeConditionType)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(NodeConditionReasonFailed))
+			Expect(condition.Message).To(ContainSubstring("Timeout"))
+		})
+
+		It("should report a reboot whose signal failed as failed", func() {
+			mockCSP.sendRebootSignalError = errors.New("CSP error")
+			reconcileOnce()
+
+			condition := nodeCondition(DefaultRebootNodeConditionType)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(NodeConditionReasonFailed))
+		})
+
+		It("should use the configured condition type", func() {
+			reconciler.Config.NodeCondition.Type = "NodeRebooting"
+			reconcileOnce()
+
+			Expect(nodeCondition("NodeRebooting")).NotTo(BeNil())
+			Expect(nodeCondition(DefaultRebootNodeConditionType)).To(BeNil())
+		})
+
+		It("should not set the condition when disabled", func() {
+			reconciler.Config.NodeCondition.Enabled = false
+			reconcileOnce()
+
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(nodeCondition(DefaultRebootNodeConditionType)).To(BeNil())
+		})
+	})
+
+	Context("when the node is already NotReady before reboot", func() {
+		BeforeEach(func() {
+			testNode.Status.Conditions = []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionFalse},
+			}
+			testNode.Status.NodeInfo.BootID = "boot-before"
+			Expect(k8sClient.Status().Update(ctx, testNode)).To(Succeed())
+
+			mockCSP.isNodeReadyResult = true
+		})
+
+		It("should require a boot ID change before declaring the reboot complete", func() {
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			// Send the reboot signal and record the pre-reboot state
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.PreRebootNodeNotReady).To(BeTrue())
+			Expect(updatedRebootNode.Status.PreRebootBootID).To(Equal("boot-before"))
+
+			// Node recovers without a new boot ID: the reboot has not been observed yet
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.Conditions = []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			}
+			Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
+
+			// Boot ID changes: the reboot is complete
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.NodeInfo.BootID = "boot-after"
+			Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition).NotTo(BeNil())
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should trust readiness alone when the verification strategy is none", func() {
+			reconciler.Config.VerificationStrategy = config.VerificationStrategyNone
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			// Node recovers without a new boot ID
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.Conditions = []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			}
+			Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)).To(Succeed())
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+
+			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
+	Context("when reboots are verified by the kubelet start time", func() {
+		var (
+			req          reconcile.Request
+			readySince   metav1.Time
+			expireReboot func()
+		)
+
+		// setReadySince reports the node Ready since the given time, as a restarted kubelet would
+		setReadySince := func(since metav1.Time) {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Status.Conditions = []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastTransitionTime: since},
+			}
+			Expect(k8sClient.Status().Update(ctx, &node)).To(Succeed())
+		}
+
+		getRebootNode := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		BeforeEach(func() {
+			reconciler.Config.VerificationStrategy = config.VerificationStrategyKubeletStartTime
+			mockCSP.isNodeReadyResult = true
+			req = reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			readySince = metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			setReadySince(readySince)
+
+			expireReboot = func() {
+				updated := getRebootNode()
+				updated.Status.StartTime = &metav1.Time{Time: time.Now().Add(-reconciler.Config.Timeout - time.Minute)}
+				Expect(k8sClient.Status().Update(ctx, &updated)).To(Succeed())
+			}
+
+			// Send the reboot signal and record the kubelet start time
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			updated := getRebootNode()
+			Expect(updated.Status.PreRebootKubeletStartTime).NotTo(BeNil())
+			Expect(updated.Status.PreRebootKubeletStartTime.Time).To(BeTemporally("==", readySince.Time))
+		})
+
+		It("should complete the reboot once the kubelet start time advanced", func() {
+			setReadySince(metav1.NewTime(time.Now().Truncate(time.Second)))
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			updated := getRebootNode()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should take the kubelet start time from the node annotation", func() {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			node.Annotations = map[string]string{KubeletStartTimeAnnotation: time.Now().Format(time.RFC3339)}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := getRebootNode()
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should fail with RebootNotObserved when the kubelet start time did not advance by the timeout", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			Expect(getRebootNode().Status.CompletionTime).To(BeNil())
+
+			expireReboot()
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := getRebootNode()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.RebootNotObserved))
+		})
+	})
+
+	Context("when the node has a reboot exclusion annotation", func() {
+		annotateNode := func(value string) {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Annotations = map[string]string{RebootExcludeAnnotation: value}
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+		}
+
+		It("should mark the reboot as excluded without sending a signal", func() {
+			annotateNode("true")
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootExcluded)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("ExcludedByAnnotation"))
+
+			// Further reconciles stay terminal
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+		})
+
+		It("should proceed with the reboot when the annotation is not true", func() {
+			annotateNode("false")
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootExcluded)).To(BeNil())
+		})
+	})
+
+	Context("when the node is a control-plane node", func() {
+		labelNode := func(labels map[string]string) {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Labels = labels
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+		}
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("should refuse to reboot it without sending a signal", func() {
+			labelNode(map[string]string{"node-role.kubernetes.io/control-plane": ""})
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+			Expect(updated.IsFailed()).To(BeTrue())
+			Expect(updated.FailureReason()).To(Equal(janitordgxcnvidiacomv1alpha1.RebootRefusedControlPlaneReason))
+
+			condition := findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootRefusedControlPlane)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+
+			// Further reconciles stay terminal
+			reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+		})
+
+		It("should refuse to reboot a node with the legacy master role", func() {
+			labelNode(map[string]string{"node-role.kubernetes.io/master": "true"})
+
+			reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+		})
+
+		It("should reboot it when the RebootNode allows control-plane nodes", func() {
+			labelNode(map[string]string{"node-role.kubernetes.io/control-plane": ""})
+
+			var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &rebootNode)).To(Succeed())
+			rebootNode.Annotations = map[string]string{AllowControlPlaneAnnotation: "true"}
+			Expect(k8sClient.Update(ctx, &rebootNode)).To(Succeed())
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootRefusedControlPlane)).To(BeNil())
+		})
+
+		It("should reboot it when the configuration allows control-plane nodes", func() {
+			labelNode(map[string]string{"node-role.kubernetes.io/control-plane": ""})
+			reconciler.Config.AllowControlPlane = true
+
+			reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+		})
+
+		It("should reboot worker nodes", func() {
+			labelNode(map[string]string{"node-role.kubernetes.io/worker": ""})
+
+			updated := reconcileAndGet()
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			Expect(findCondition(updated.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionRebootRefusedControlPlane)).To(BeNil())
+		})
+	})
+
+	Context("when the node carries tuning annotations", func() {
+		BeforeEach(func() {
+			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+			testRebootNode.Status.Conditions = []metav1.Condition{
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Succeeded",
+					Message:            "test-request-ref",
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+					Status:             metav1.ConditionUnknown,
+					Reason:             "Initializing",
+					Message:            "Node ready state not yet determined",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			testRebootNode.Status.RetryCount = 3
+
+			Expect(k8sClient.Status().Update(ctx, testRebootNode)).To(Succeed())
+
+			mockCSP.isNodeReadyResult = false
+		})
+
+		annotateNode := func(annotations map[string]string) {
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			node.Annotations = annotations
+			Expect(k8sClient.Update(ctx, &node)).To(Succeed())
+		}
+
+		reconcileAndGet := func() janitordgxcnvidiacomv1alpha1.RebootNode {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updated)).To(Succeed())
+
+			return updated
+		}
+
+		It("should fail once the annotated retry limit is reached", func() {
+			annotateNode(map[string]string{MaxRetriesAnnotation: "3"})
+
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
+
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("MaxRetriesExceeded"))
+			Expect(condition.Message).To(ContainSubstring("after 3 retries"))
+		})
+
+		It("should flag a reboot that exceeded its retries for attention", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+			exhaustedBefore := gatheredCounterValue("janitor_reboot_retries_exhausted_total")
 
-			// Verify reboot signal was sent exactly once
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			annotateNode(map[string]string{MaxRetriesAnnotation: "3"})
+			reconcileAndGet()
 
-			// Get updated RebootNode
-			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
-			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
-			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("Warning MaxRetriesExceeded")))
+			Expect(gatheredCounterValue("janitor_reboot_retries_exhausted_total")).To(Equal(exhaustedBefore + 1))
 
-			// Verify conditions are properly set
-			Expect(updatedRebootNode.Status.Conditions).To(HaveLen(2))
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue(NeedsAttentionAnnotation, "true"))
 
-			// Check SignalSent condition
-			signalSentCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
-			Expect(signalSentCondition).NotTo(BeNil())
-			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionTrue))
-			Expect(signalSentCondition.Reason).To(Equal("Succeeded"))
-			Expect(signalSentCondition.Message).To(Equal("test-request-ref"))
+			// The completed RebootNode is not flagged again
+			reconcileAndGet()
+			Expect(recorder.Events).NotTo(Receive())
+		})
 
-			// Check NodeReady condition (should be Unknown initially)
-			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
-			Expect(nodeReadyCondition).NotTo(BeNil())
-			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionUnknown))
+		It("should not flag a reboot that timed out", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
 
-			// Verify StartTime is set
-			Expect(updatedRebootNode.Status.StartTime).NotTo(BeNil())
+			annotateNode(map[string]string{RebootTimeoutAnnotation: "1m"})
+			reconcileAndGet()
 
-			// Verify IsRebootInProgress returns true
-			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+			Expect(recorder.Events).NotTo(Receive())
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey(NeedsAttentionAnnotation))
 		})
 
-		It("should NOT send multiple reboot signals on subsequent reconciliations", func() {
-			// First reconciliation - should send reboot signal
-			req := reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name: testRebootNode.Name,
-				},
-			}
+		It("should time out using the annotated timeout instead of the controller timeout", func() {
+			annotateNode(map[string]string{RebootTimeoutAnnotation: "1m"})
 
-			_, err := reconciler.Reconcile(ctx, req)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).NotTo(BeNil())
 
-			// Second reconciliation - should NOT send another reboot signal
-			_, err = reconciler.Reconcile(ctx, req)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1)) // Still 1, not 2!
+			condition := findCondition(updated.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("Timeout"))
+		})
 
-			// Third reconciliation - should STILL NOT send another reboot signal
-			_, err = reconciler.Reconcile(ctx, req)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1)) // Still 1, not 3!
+		It("should ignore malformed annotations and keep monitoring", func() {
+			annotateNode(map[string]string{
+				RebootTimeoutAnnotation: "soon",
+				MaxRetriesAnnotation:    "-1",
+			})
+
+			updated := reconcileAndGet()
+			Expect(updated.Status.CompletionTime).To(BeNil())
+			Expect(updated.Status.RetryCount).To(Equal(int32(4)))
 		})
 	})
 
-	Context("when reboot is in progress", func() {
+	Context("when the CSP readiness check times out", func() {
 		BeforeEach(func() {
-			// Set up RebootNode as if reboot signal was already sent
 			testRebootNode.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
 			testRebootNode.Status.Conditions = []metav1.Condition{
 				{
@@ -261,15 +3164,13 @@ var _ = Describe("RebootNode Controller", func() {
 				},
 			}
 
-			// Update the object in the fake client
 			err := k8sClient.Status().Update(ctx, testRebootNode)
 			Expect(err).NotTo(HaveOccurred())
-		})
 
-		It("should monitor node status and complete when node is ready", func() {
-			// Set mock to return node as ready
-			mockCSP.isNodeReadyResult = true
+			mockCSP.isNodeReadyError = context.DeadlineExceeded
+		})
 
+		It("should record the next attempt time matching the computed backoff", func() {
 			req := reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name: testRebootNode.Name,
@@ -278,84 +3179,47 @@ var _ = Describe("RebootNode Controller", func() {
 
 			result, err := reconciler.Reconcile(ctx, req)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(result.RequeueAfter).To(Equal(time.Duration(0))) // Should not requeue on completion
-
-			// Verify no additional reboot signals were sent
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
+			Expect(result.RequeueAfter).To(Equal(getNextRequeueDelay(1)))
 
-			// Get updated RebootNode
 			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
 			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
 			Expect(err).NotTo(HaveOccurred())
 
-			// Verify completion
-			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
-
-			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
-			Expect(nodeReadyCondition).NotTo(BeNil())
-			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionTrue))
-			Expect(nodeReadyCondition.Reason).To(Equal("Succeeded"))
+			Expect(updatedRebootNode.Status.ConsecutiveFailures).To(Equal(int32(1)))
+			Expect(updatedRebootNode.Status.NextAttemptTime).NotTo(BeNil())
+			Expect(updatedRebootNode.Status.NextAttemptTime.Time).To(
+				BeTemporally("~", time.Now().Add(result.RequeueAfter), 2*time.Second))
 		})
 
-		It("should continue monitoring when node is not ready", func() {
-			// Set mock to return node as not ready
-			mockCSP.isNodeReadyResult = false
-
+		It("should clear the next attempt time once the reboot completes", func() {
 			req := reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name: testRebootNode.Name,
 				},
 			}
 
-			result, err := reconciler.Reconcile(ctx, req)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(result.RequeueAfter).To(Equal(30 * time.Second)) // Should requeue for monitoring
-
-			// Verify no additional reboot signals were sent
-			Expect(mockCSP.sendRebootSignalCalled).To(Equal(0))
-
-			// Get updated RebootNode
-			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
-			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
-			Expect(err).NotTo(HaveOccurred())
-
-			// Verify still in progress
-			Expect(updatedRebootNode.Status.CompletionTime).To(BeNil())
-			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
-		})
-
-		It("should timeout after configured duration", func() {
-			// Set start time to be past the timeout
-			pastTime := time.Now().Add(-35 * time.Minute) // Past 30 minute timeout
-			testRebootNode.Status.StartTime = &metav1.Time{Time: pastTime}
-			err := k8sClient.Status().Update(ctx, testRebootNode)
+			_, err := reconciler.Reconcile(ctx, req)
 			Expect(err).NotTo(HaveOccurred())
 
-			// Set mock to return node as not ready
-			mockCSP.isNodeReadyResult = false
+			// The backoff elapses
+			var backingOff janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &backingOff)).To(Succeed())
+			backingOff.Status.NextAttemptTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+			Expect(k8sClient.Status().Update(ctx, &backingOff)).To(Succeed())
 
-			req := reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name: testRebootNode.Name,
-				},
-			}
+			mockCSP.isNodeReadyError = nil
+			mockCSP.isNodeReadyResult = true
 
 			result, err := reconciler.Reconcile(ctx, req)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(result.RequeueAfter).To(Equal(time.Duration(0))) // Should not requeue on timeout
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
 
-			// Get updated RebootNode
 			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
 			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
 			Expect(err).NotTo(HaveOccurred())
 
-			// Verify timeout
 			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
-
-			nodeReadyCondition := findCondition(updatedRebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
-			Expect(nodeReadyCondition).NotTo(BeNil())
-			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionFalse))
-			Expect(nodeReadyCondition.Reason).To(Equal("Timeout"))
+			Expect(updatedRebootNode.Status.NextAttemptTime).To(BeNil())
 		})
 	})
 
@@ -409,7 +3273,8 @@ var _ = Describe("RebootNode Controller", func() {
 			Expect(nodeReadyCondition).NotTo(BeNil())
 			Expect(nodeReadyCondition.Status).To(Equal(metav1.ConditionFalse))
 			Expect(nodeReadyCondition.Reason).To(Equal("Failed"))
-			Expect(nodeReadyCondition.Message).To(Equal("Node status could not be checked from CSP: CSP error"))
+			Expect(nodeReadyCondition.Message).To(HavePrefix("Node status could not be checked from CSP: IsNodeReady failed for node test-node"))
+			Expect(nodeReadyCondition.Message).To(HaveSuffix(": CSP error"))
 
 			// Verify IsRebootInProgress returns true
 			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
@@ -445,7 +3310,7 @@ var _ = Describe("RebootNode Controller", func() {
 			Expect(signalSentCondition).NotTo(BeNil())
 			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionFalse))
 			Expect(signalSentCondition.Reason).To(Equal("Failed"))
-			Expect(signalSentCondition.Message).To(Equal("CSP error"))
+			Expect(signalSentCondition.Message).To(Equal("SendRebootSignal failed for node test-node: CSP error"))
 
 			// Verify IsRebootInProgress returns false (since signal failed)
 			Expect(updatedRebootNode.IsRebootInProgress()).To(BeFalse())
@@ -455,6 +3320,197 @@ var _ = Describe("RebootNode Controller", func() {
 		})
 	})
 
+	Context("when finalizer names are configured", func() {
+		var finalizerRebootNode *janitordgxcnvidiacomv1alpha1.RebootNode
+
+		BeforeEach(func() {
+			finalizerRebootNode = &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "finalizer-rebootnode",
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{
+					NodeName: "test-node",
+				},
+			}
+			Expect(k8sClient.Create(ctx, finalizerRebootNode)).To(Succeed())
+		})
+
+		It("should add the default finalizer when none is configured", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: finalizerRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ConsistOf(RebootNodeFinalizer))
+		})
+
+		It("should add and remove a custom finalizer", func() {
+			customFinalizer := "janitor.dgxc.nvidia.com/instance-b"
+			reconciler.Config.FinalizerName = customFinalizer
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: finalizerRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ConsistOf(customFinalizer))
+
+			// Deletion is blocked by the custom finalizer until the reconciler removes it
+			Expect(k8sClient.Delete(ctx, &updated)).To(Succeed())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updated)).To(Succeed())
+			Expect(updated.DeletionTimestamp).NotTo(BeNil())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, req.NamespacedName, &updated)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("when a RebootNode is deleted", func() {
+		var deletedRebootNode *janitordgxcnvidiacomv1alpha1.RebootNode
+
+		BeforeEach(func() {
+			deletedRebootNode = &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "deleted-rebootnode",
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{
+					NodeName: "test-node",
+				},
+			}
+			Expect(k8sClient.Create(ctx, deletedRebootNode)).To(Succeed())
+		})
+
+		abandonedReboots := func() float64 {
+			return gatheredCounterValue("janitor_reboot_abandoned_total")
+		}
+
+		deleteAndReconcile := func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name}}
+
+			var current janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, req.NamespacedName, &current)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+
+		It("should count a reboot deleted while in progress as abandoned", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			before := abandonedReboots()
+			deleteAndReconcile()
+			Expect(abandonedReboots()).To(Equal(before + 1))
+		})
+
+		It("should report a reboot deleted while in progress as abandoned on the node", func() {
+			reconciler.Config.NodeCondition.Enabled = true
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			deleteAndReconcile()
+
+			var node corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: testNode.Name}, &node)).To(Succeed())
+
+			var reasons []string
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == DefaultRebootNodeConditionType {
+					Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+					reasons = append(reasons, condition.Reason)
+				}
+			}
+			Expect(reasons).To(Equal([]string{NodeConditionReasonAbandoned}))
+		})
+
+		It("should cancel a CSP operation still pending before removing the finalizer", func() {
+			cspClient := &cancellingCSPClient{
+				pendingOperationCSPClient: &pendingOperationCSPClient{mockCSPClient: mockCSP, pending: true},
+			}
+			reconciler.CSPClient = cspClient
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSP.sendRebootSignalCalled).To(Equal(1))
+
+			deleteAndReconcile()
+			Expect(cspClient.checks).To(Equal(1))
+			Expect(cspClient.cancelled).To(Equal([]string{testNode.Name}))
+		})
+
+		It("should remove the finalizer when no CSP operation is pending", func() {
+			cspClient := &cancellingCSPClient{
+				pendingOperationCSPClient: &pendingOperationCSPClient{mockCSPClient: mockCSP},
+			}
+			reconciler.CSPClient = cspClient
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			deleteAndReconcile()
+			Expect(cspClient.checks).To(Equal(1))
+			Expect(cspClient.cancelled).To(BeEmpty())
+		})
+
+		It("should remove the finalizer when the provider cannot cancel a pending CSP operation", func() {
+			cspClient := &pendingOperationCSPClient{mockCSPClient: mockCSP, pending: true}
+			reconciler.CSPClient = cspClient
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			deleteAndReconcile()
+			Expect(cspClient.checks).To(Equal(1))
+		})
+
+		It("should not check for a pending CSP operation when no reboot signal was sent", func() {
+			cspClient := &cancellingCSPClient{
+				pendingOperationCSPClient: &pendingOperationCSPClient{mockCSPClient: mockCSP, pending: true},
+			}
+			reconciler.CSPClient = cspClient
+
+			deleteAndReconcile()
+			Expect(cspClient.checks).To(BeZero())
+			Expect(cspClient.cancelled).To(BeEmpty())
+		})
+
+		It("should not count a completed reboot as abandoned", func() {
+			mockCSP.sendRebootSignalError = errors.New("CSP error")
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: deletedRebootNode.Name},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			before := abandonedReboots()
+			deleteAndReconcile()
+			Expect(abandonedReboots()).To(Equal(before))
+		})
+	})
+
 	Context("testing race condition prevention", func() {
 		It("should properly handle the initialization race condition", func() {
 			// This test specifically targets the race condition where:
@@ -772,3 +3828,112 @@ func TestConditionsChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusEqual(t *testing.T) {
+	// A reading carrying a monotonic clock and a location, as set by metav1.Now()
+	now := time.Now()
+	// The same instant as read back from the API server
+	persisted := now.Truncate(time.Second).UTC()
+
+	condition := func(transition time.Time) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Succeeded",
+			Message:            "test-request-ref",
+			LastTransitionTime: metav1.NewTime(transition),
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		original NodeActionStatus
+		updated  NodeActionStatus
+		want     bool
+	}{
+		{
+			name: "reboot times differing only in monotonic clock, location and sub-second precision",
+			original: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+				StartTime:       &metav1.Time{Time: persisted},
+				NextAttemptTime: &metav1.Time{Time: persisted.Add(time.Minute)},
+				Conditions:      condition(persisted),
+			},
+			updated: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+				StartTime:       &metav1.Time{Time: now},
+				NextAttemptTime: &metav1.Time{Time: now.Add(time.Minute)},
+				Conditions:      condition(now),
+			},
+			want: true,
+		},
+		{
+			name: "terminate times differing only in monotonic clock",
+			original: &janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{
+				StartTime:  &metav1.Time{Time: now.Round(0)},
+				Conditions: condition(now.Round(0)),
+			},
+			updated: &janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{
+				StartTime:  &metav1.Time{Time: now},
+				Conditions: condition(now),
+			},
+			want: true,
+		},
+		{
+			name:     "start time moved",
+			original: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{StartTime: &metav1.Time{Time: persisted}},
+			updated:  &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{StartTime: &metav1.Time{Time: now.Add(time.Minute)}},
+			want:     false,
+		},
+		{
+			name:     "completion time set",
+			original: &janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{StartTime: &metav1.Time{Time: persisted}},
+			updated: &janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{
+				StartTime:      &metav1.Time{Time: persisted},
+				CompletionTime: &metav1.Time{Time: now},
+			},
+			want: false,
+		},
+		{
+			name:     "field outside the common status changed",
+			original: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{},
+			updated:  &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{SLABreached: true},
+			want:     false,
+		},
+		{
+			name:     "condition changed",
+			original: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{Conditions: condition(persisted)},
+			updated: &janitordgxcnvidiacomv1alpha1.RebootNodeStatus{Conditions: []metav1.Condition{{
+				Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+				Status:             metav1.ConditionFalse,
+				Reason:             "Failed",
+				Message:            "CSP error",
+				LastTransitionTime: metav1.NewTime(persisted),
+			}}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusEqual(tt.original, tt.updated); got != tt.want {
+				t.Errorf("statusEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// gatheredCounterValue returns the value of an unlabeled counter registered with the controller metrics registry
+func gatheredCounterValue(name string) float64 {
+	families, err := ctrlmetrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() == name {
+			return family.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+
+	// Counters without observations are still gathered, so a missing one is not registered
+	Fail("metric " + name + " is not registered")
+
+	return 0
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// rebootNodeLivenessCheckName is the name of the health check reporting a stuck RebootNode queue
+const rebootNodeLivenessCheckName = "rebootnode-reconcile"
+
+// reconcileLiveness detects a stuck reconcile queue, e.g. every worker blocked in a hung CSP call.
+// It reports unhealthy when no reconcile completed within the window while unfinished RebootNodes
+// exist. Unfinished RebootNodes are requeued at most getNextRequeueDelay apart, so the window must
+// be longer than the maximum backoff delay.
+//
+// It is added to the manager as a runnable so that it only starts checking once this instance is
+// the leader; standby instances never reconcile and always report healthy.
+type reconcileLiveness struct {
+	client client.Reader
+	window time.Duration
+	now    func() time.Time
+
+	started atomic.Bool
+	// lastCompleted is the time of the last completed reconcile in Unix nanoseconds
+	lastCompleted atomic.Int64
+}
+
+func newReconcileLiveness(reader client.Reader, window time.Duration) *reconcileLiveness {
+	return &reconcileLiveness{
+		client: reader,
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// Start counts the start of the controller as the last reconcile, so that it is given a full window,
+// and enables the check. It implements manager.Runnable.
+func (l *reconcileLiveness) Start(ctx context.Context) error {
+	l.observe()
+	l.started.Store(true)
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// observe records that a reconcile completed, whatever its result
+func (l *reconcileLiveness) observe() {
+	l.lastCompleted.Store(l.now().UnixNano())
+}
+
+// Check implements healthz.Checker. RebootNodes are listed from the manager cache.
+func (l *reconcileLiveness) Check(req *http.Request) error {
+	if !l.started.Load() {
+		return nil
+	}
+
+	sinceLast := l.now().Sub(time.Unix(0, l.lastCompleted.Load()))
+	if sinceLast <= l.window {
+		return nil
+	}
+
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := l.client.List(req.Context(), &rebootNodes); err != nil {
+		return fmt.Errorf("failed to list rebootnodes: %w", err)
+	}
+
+	unfinished := 0
+
+	for i := range rebootNodes.Items {
+		if rebootNodes.Items[i].Status.CompletionTime == nil {
+			unfinished++
+		}
+	}
+
+	if unfinished == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("no rebootnode reconcile completed in %s while %d rebootnodes are unfinished",
+		sinceLast.Round(time.Second), unfinished)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+func TestReconcileLiveness_Check(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	completed := metav1.Now()
+	finished := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "finished"},
+		Status:     janitordgxcnvidiacomv1alpha1.RebootNodeStatus{CompletionTime: &completed},
+	}
+	unfinished := &janitordgxcnvidiacomv1alpha1.RebootNode{ObjectMeta: metav1.ObjectMeta{Name: "unfinished"}}
+
+	tests := []struct {
+		name        string
+		rebootNodes []client.Object
+		started     bool
+		elapsed     time.Duration
+		wantErr     bool
+	}{
+		{
+			name:        "within the window",
+			rebootNodes: []client.Object{unfinished},
+			started:     true,
+			elapsed:     5 * time.Minute,
+		},
+		{
+			name:        "window elapsed with unfinished rebootnodes",
+			rebootNodes: []client.Object{finished, unfinished},
+			started:     true,
+			elapsed:     20 * time.Minute,
+			wantErr:     true,
+		},
+		{
+			name:        "window elapsed with only finished rebootnodes",
+			rebootNodes: []client.Object{finished},
+			started:     true,
+			elapsed:     20 * time.Minute,
+		},
+		{
+			name:        "not the leader",
+			rebootNodes: []client.Object{unfinished},
+			elapsed:     20 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.rebootNodes...).Build()
+			liveness := newReconcileLiveness(reader, 15*time.Minute)
+
+			now := time.Now()
+			liveness.now = func() time.Time { return now }
+			liveness.observe()
+			liveness.started.Store(tt.started)
+
+			now = now.Add(tt.elapsed)
+
+			err := liveness.Check(httptest.NewRequest("GET", "/healthz", nil))
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "1 rebootnodes are unfinished")
+
+				// A completed reconcile restores the check
+				liveness.observe()
+				assert.NoError(t, liveness.Check(httptest.NewRequest("GET", "/healthz", nil)))
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestReconcileLiveness_StartResetsWindow(t *testing.T) {
+	liveness := newReconcileLiveness(nil, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- liveness.Start(ctx) }()
+
+	assert.Eventually(t, liveness.started.Load, time.Second, 10*time.Millisecond)
+	assert.NoError(t, liveness.Check(httptest.NewRequest("GET", "/healthz", nil)))
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+// rebootNodePhaseReportInterval is how often the RebootNode phase counts are refreshed
+const rebootNodePhaseReportInterval = 30 * time.Second
+
+// reconcileResult maps the outcome of a reconcile to its metric label
+func reconcileResult(result ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return metrics.ReconcileResultError
+	case !result.IsZero():
+		return metrics.ReconcileResultRequeue
+	default:
+		return metrics.ReconcileResultSuccess
+	}
+}
+
+// reconcileOutcome classifies a RebootNode reconcile by the status change it made and its result.
+// A reconcile that returned an error is failed whatever branch it took.
+func reconcileOutcome(
+	original, updated *janitordgxcnvidiacomv1alpha1.RebootNode,
+	result ctrl.Result,
+	err error,
+) string {
+	switch {
+	case err != nil:
+		return metrics.ReconcileOutcomeFailed
+	case original.Status.CompletionTime == nil && updated.IsFailed():
+		return metrics.ReconcileOutcomeFailed
+	case original.Status.CompletionTime == nil && updated.Status.CompletionTime != nil:
+		return metrics.ReconcileOutcomeCompleted
+	case !isSignalSent(original) && isSignalSent(updated):
+		return metrics.ReconcileOutcomeSignalSent
+	case result.IsZero():
+		return metrics.ReconcileOutcomeNoop
+	case updated.IsRebootInProgress():
+		return metrics.ReconcileOutcomeMonitoring
+	default:
+		return metrics.ReconcileOutcomeRequeued
+	}
+}
+
+// isSignalSent returns true if the reboot signal of the RebootNode was sent successfully
+func isSignalSent(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	return meta.IsStatusConditionTrue(rebootNode.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)
+}
+
+// recordRebootPhaseDurations records the duration of the phases a completed RebootNode went through
+func recordRebootPhaseDurations(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) {
+	if queued, ok := rebootNode.QueuedDuration(); ok {
+		metrics.GlobalMetrics.RecordRebootPhaseDuration(metrics.RebootPhaseQueued, queued)
+	}
+
+	if rebooting, ok := rebootNode.RebootingDuration(); ok {
+		metrics.GlobalMetrics.RecordRebootPhaseDuration(metrics.RebootPhaseRebooting, rebooting)
+	}
+}
+
+// rebootNodePhase returns the phase a RebootNode is reported under
+func rebootNodePhase(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) string {
+	switch {
+	case rebootNode.Status.CompletionTime != nil:
+		return metrics.PhaseCompleted
+	case rebootNode.IsRebootInProgress():
+		return metrics.PhaseInProgress
+	default:
+		return metrics.PhasePending
+	}
+}
+
+// rebootNodePhaseReporter periodically lists RebootNode objects and publishes their count by phase,
+// showing whether the controller keeps up with incoming reboot requests
+type rebootNodePhaseReporter struct {
+	client   client.Reader
+	interval time.Duration
+}
+
+// Start reports the phase counts every interval until the context is cancelled. It implements manager.Runnable.
+func (p *rebootNodePhaseReporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.report(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to report rebootnode phases")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// report lists all RebootNode objects and sets the count for every phase
+func (p *rebootNodePhaseReporter) report(ctx context.Context) error {
+	var rebootNodes janitordgxcnvidiacomv1alpha1.RebootNodeList
+	if err := p.client.List(ctx, &rebootNodes); err != nil {
+		return err
+	}
+
+	counts := map[string]int{
+		metrics.PhasePending:    0,
+		metrics.PhaseInProgress: 0,
+		metrics.PhaseCompleted:  0,
+	}
+
+	for i := range rebootNodes.Items {
+		counts[rebootNodePhase(&rebootNodes.Items[i])]++
+	}
+
+	for phase, count := range counts {
+		metrics.GlobalMetrics.SetRebootNodePhaseCount(phase, count)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+// gatherMetric returns the registered metric of the given family whose labels include all of labels
+func gatherMetric(t *testing.T, family string, labels map[string]string) *dto.Metric {
+	t.Helper()
+
+	families, err := ctrlmetrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, f := range families {
+		if f.GetName() != family {
+			continue
+		}
+
+		for _, m := range f.GetMetric() {
+			matched := 0
+
+			for _, pair := range m.GetLabel() {
+				if value, ok := labels[pair.GetName()]; ok && value == pair.GetValue() {
+					matched++
+				}
+			}
+
+			if matched == len(labels) {
+				return m
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileObservations returns the number of reconcile durations recorded for the action type and result
+func reconcileObservations(t *testing.T, actionType, result string) uint64 {
+	t.Helper()
+
+	m := gatherMetric(t, "janitor_reconcile_duration_seconds",
+		map[string]string{"action_type": actionType, "result": result})
+	if m == nil {
+		return 0
+	}
+
+	return m.GetHistogram().GetSampleCount()
+}
+
+// reconcileOutcomeCount returns the number of RebootNode reconciles counted with the outcome
+func reconcileOutcomeCount(t *testing.T, outcome string) float64 {
+	t.Helper()
+
+	m := gatherMetric(t, "janitor_reconcile_total", map[string]string{"outcome": outcome})
+	if m == nil {
+		return 0
+	}
+
+	return m.GetCounter().GetValue()
+}
+
+func TestReconcileResult(t *testing.T) {
+	assert.Equal(t, metrics.ReconcileResultSuccess, reconcileResult(ctrl.Result{}, nil))
+	assert.Equal(t, metrics.ReconcileResultRequeue, reconcileResult(ctrl.Result{RequeueAfter: time.Second}, nil))
+	assert.Equal(t, metrics.ReconcileResultError, reconcileResult(ctrl.Result{}, assert.AnError))
+}
+
+func TestRebootNodeReconciler_RecordsReconcileDuration(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-node"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: node.Name},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	r := &RebootNodeReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(node, rebootNode).
+			WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+			Build(),
+		Config:    &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute},
+		CSPClient: &mockCSPClient{sendRebootSignalResult: model.ResetSignalRequestRef("ref")},
+	}
+
+	requeueBefore := reconcileObservations(t, metrics.ActionTypeReboot, metrics.ReconcileResultRequeue)
+	successBefore := reconcileObservations(t, metrics.ActionTypeReboot, metrics.ReconcileResultSuccess)
+
+	// Sending the reboot signal requeues to monitor the node
+	_, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: rebootNode.Name},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, requeueBefore+1, reconcileObservations(t, metrics.ActionTypeReboot, metrics.ReconcileResultRequeue))
+
+	// A RebootNode that no longer exists reconciles successfully without requeueing
+	_, err = r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "missing-rebootnode"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, successBefore+1, reconcileObservations(t, metrics.ActionTypeReboot, metrics.ReconcileResultSuccess))
+}
+
+func TestReconcileOutcome(t *testing.T) {
+	now := metav1.Now()
+	signalSent := metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent,
+		Status: metav1.ConditionTrue,
+	}
+	notReady := metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status: metav1.ConditionUnknown,
+	}
+	timedOut := metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status: metav1.ConditionFalse,
+		Reason: "Timeout",
+	}
+
+	pending := &janitordgxcnvidiacomv1alpha1.RebootNode{}
+	inProgress := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+			Conditions: []metav1.Condition{signalSent, notReady},
+		},
+	}
+	failed := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+			CompletionTime: &now,
+			Conditions:     []metav1.Condition{signalSent, timedOut},
+		},
+	}
+	requeue := ctrl.Result{RequeueAfter: time.Minute}
+
+	tests := []struct {
+		name     string
+		original *janitordgxcnvidiacomv1alpha1.RebootNode
+		updated  *janitordgxcnvidiacomv1alpha1.RebootNode
+		result   ctrl.Result
+		err      error
+		expected string
+	}{
+		{"signal sent", pending, inProgress, requeue, nil, metrics.ReconcileOutcomeSignalSent},
+		{"reboot polled", inProgress, inProgress, requeue, nil, metrics.ReconcileOutcomeMonitoring},
+		{"reboot timed out", inProgress, failed, ctrl.Result{}, nil, metrics.ReconcileOutcomeFailed},
+		{"waiting for a reboot slot", pending, pending, requeue, nil, metrics.ReconcileOutcomeRequeued},
+		{"nothing to do", pending, pending, ctrl.Result{}, nil, metrics.ReconcileOutcomeNoop},
+		{"status update failed", pending, inProgress, ctrl.Result{}, assert.AnError, metrics.ReconcileOutcomeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, reconcileOutcome(tt.original, tt.updated, tt.result, tt.err))
+		})
+	}
+}
+
+func TestRebootNodeReconciler_RecordsReconcileOutcome(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "outcome-node"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "outcome-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: node.Name},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	cspClient := &mockCSPClient{
+		sendRebootSignalResult: model.ResetSignalRequestRef("ref"),
+		isNodeReadyResult:      true,
+		rebootIncomplete:       true,
+	}
+	r := &RebootNodeReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(node, rebootNode).
+			WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+			Build(),
+		Config:    &config.RebootNodeControllerConfig{Timeout: 30 * time.Minute, FirstCheckDelay: time.Nanosecond},
+		CSPClient: cspClient,
+	}
+
+	expectOutcome := func(name, outcome string) {
+		t.Helper()
+
+		before := reconcileOutcomeCount(t, outcome)
+
+		_, err := r.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, before+1, reconcileOutcomeCount(t, outcome))
+	}
+
+	expectOutcome(rebootNode.Name, metrics.ReconcileOutcomeSignalSent)
+
+	// The CSP has not finished the reboot operation yet
+	expectOutcome(rebootNode.Name, metrics.ReconcileOutcomeMonitoring)
+
+	cspClient.rebootIncomplete = false
+
+	expectOutcome(rebootNode.Name, metrics.ReconcileOutcomeCompleted)
+	expectOutcome(rebootNode.Name, metrics.ReconcileOutcomeNoop)
+	expectOutcome("missing-rebootnode", metrics.ReconcileOutcomeNoop)
+}
+
+func TestRebootNodePhaseReporter_Report(t *testing.T) {
+	completion := metav1.Now()
+
+	objects := []*janitordgxcnvidiacomv1alpha1.RebootNode{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pending-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pending-2"}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "in-progress"},
+			Status: janitordgxcnvidiacomv1alpha1.RebootNodeStatus{
+				Conditions: []metav1.Condition{
+					{Type: janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent, Status: metav1.ConditionTrue},
+					{Type: janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "completed"},
+			Status:     janitordgxcnvidiacomv1alpha1.RebootNodeStatus{CompletionTime: &completion},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, obj := range objects {
+		builder = builder.WithObjects(obj)
+	}
+
+	reporter := &rebootNodePhaseReporter{client: builder.Build(), interval: time.Minute}
+	require.NoError(t, reporter.report(context.Background()))
+
+	expected := map[string]float64{
+		metrics.PhasePending:    2,
+		metrics.PhaseInProgress: 1,
+		metrics.PhaseCompleted:  1,
+	}
+
+	for phase, count := range expected {
+		m := gatherMetric(t, "janitor_rebootnodes", map[string]string{"phase": phase})
+		require.NotNil(t, m, "phase %s", phase)
+		assert.Equal(t, count, m.GetGauge().GetValue(), "phase %s", phase)
+	}
+}
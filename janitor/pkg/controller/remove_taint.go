@@ -0,0 +1,77 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// hasTaint returns true if the node has a taint with the key, whatever its value and effect
+func hasTaint(node *corev1.Node, key string) bool {
+	return slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return taint.Key == key
+	})
+}
+
+// shouldRemoveTaint returns true if the RebootNode asks for a taint to be removed on success and the
+// node still has it
+func shouldRemoveTaint(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node) bool {
+	key := rebootNode.Annotations[RemoveTaintAnnotation]
+
+	return key != "" && hasTaint(node, key)
+}
+
+// removeTaint removes the taint named by the RebootNode from the rebooted node and requeues the
+// RebootNode to succeed. The taint is removed before the reboot succeeds, so that a completed reboot
+// never leaves the node tainted; a failed removal is retried with backoff. Only failed removals count
+// towards the retry limit, so that the requeue to succeed cannot exhaust it.
+func (r *RebootNodeReconciler) removeTaint(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	logger := log.FromContext(ctx)
+	key := rebootNode.Annotations[RemoveTaintAnnotation]
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return taint.Key == key
+	})
+
+	if err := r.Patch(ctx, node, patch); err != nil {
+		logger.Error(err, "failed to remove taint from rebooted node",
+			"node", node.Name,
+			"taint", key)
+
+		rebootNode.Status.ConsecutiveFailures++
+
+		return ctrl.Result{RequeueAfter: getNextRequeueDelay(rebootNode.Status.ConsecutiveFailures)}
+	}
+
+	logger.Info("removed taint from rebooted node",
+		"node", node.Name,
+		"taint", key)
+
+	return requeueUncounted(rebootNode, time.Second)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/notification"
+)
+
+// flagRetriesExhausted makes a reboot that ran out of retries stand out for manual intervention: it
+// is counted, a Warning Event is emitted on the RebootNode and the node is annotated with
+// NeedsAttentionAnnotation. A failed annotation is logged rather than failing the reconcile.
+func (r *RebootNodeReconciler) flagRetriesExhausted(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) {
+	logger := log.FromContext(ctx)
+
+	metrics.GlobalMetrics.IncRebootRetriesExhausted()
+
+	if r.Recorder != nil {
+		r.Recorder.Eventf(rebootNode, corev1.EventTypeWarning, notification.OutcomeMaxRetriesExceeded,
+			"Reboot of node %s exceeded its maximum number of retries and needs attention", rebootNode.Spec.NodeName)
+	}
+
+	var node corev1.Node
+	if err := r.Get(ctx, client.ObjectKey{Name: rebootNode.Spec.NodeName}, &node); err != nil {
+		logger.Error(err, "failed to get node to flag for attention",
+			"node", rebootNode.Spec.NodeName)
+
+		return
+	}
+
+	if node.Annotations[NeedsAttentionAnnotation] == "true" {
+		return
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	node.Annotations[NeedsAttentionAnnotation] = "true"
+
+	if err := r.Patch(ctx, &node, patch); err != nil {
+		logger.Error(err, "failed to annotate node for attention",
+			"node", node.Name,
+			"annotation", NeedsAttentionAnnotation)
+
+		return
+	}
+
+	logger.Info("flagged node for attention after exhausting reboot retries",
+		"node", node.Name,
+		"annotation", NeedsAttentionAnnotation)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// allocatableGPUs returns the number of GPUs the node advertises as allocatable
+func allocatableGPUs(node *corev1.Node) int64 {
+	gpus := node.Status.Allocatable[GPUResourceName]
+
+	return gpus.Value()
+}
+
+// spareGPUCapacity returns the allocatable GPUs of the ready nodes other than the target, i.e. the GPU
+// capacity left to the cluster while the target reboots. The nodes are listed from the manager cache.
+func (r *RebootNodeReconciler) spareGPUCapacity(ctx context.Context, nodeName string) (int64, error) {
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes); err != nil {
+		return 0, err
+	}
+
+	var spare int64
+
+	for i := range nodes.Items {
+		if nodes.Items[i].Name != nodeName && isNodeReady(&nodes.Items[i]) {
+			spare += allocatableGPUs(&nodes.Items[i])
+		}
+	}
+
+	return spare, nil
+}
+
+// hasSpareCapacity returns true if the cluster keeps at least the configured number of allocatable GPUs
+// on the other ready nodes while the target reboots, or if the check is disabled. If the nodes cannot
+// be listed the reboot waits. A RebootNode deferred earlier records that the capacity is back.
+func (r *RebootNodeReconciler) hasSpareCapacity(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) bool {
+	minSpareGPUs := r.Config.SpareCapacity.MinSpareGPUs
+	if minSpareGPUs <= 0 {
+		return true
+	}
+
+	spare, err := r.spareGPUCapacity(ctx, rebootNode.Spec.NodeName)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to list nodes, waiting for spare capacity",
+			"node", rebootNode.Spec.NodeName)
+
+		return false
+	}
+
+	if spare < minSpareGPUs {
+		return false
+	}
+
+	if meta.IsStatusConditionTrue(rebootNode.Status.Conditions,
+		janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity) {
+		log.FromContext(ctx).Info("spare capacity available", "node", rebootNode.Spec.NodeName)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity,
+			Status:             metav1.ConditionFalse,
+			Reason:             "SpareCapacityAvailable",
+			Message:            fmt.Sprintf("%d GPUs allocatable on the other ready nodes", spare),
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	return true
+}
+
+// deferForSpareCapacity records that the reboot is deferred until enough GPU capacity is available on
+// the other ready nodes and requeues the RebootNode to check again
+func (r *RebootNodeReconciler) deferForSpareCapacity(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) ctrl.Result {
+	log.FromContext(ctx).Info("insufficient spare capacity, deferring reboot",
+		"node", rebootNode.Spec.NodeName,
+		"minSpareGPUs", r.Config.SpareCapacity.MinSpareGPUs)
+
+	rebootNode.SetCondition(metav1.Condition{
+		Type:   janitordgxcnvidiacomv1alpha1.RebootNodeConditionInsufficientSpareCapacity,
+		Status: metav1.ConditionTrue,
+		Reason: "InsufficientSpareCapacity",
+		Message: fmt.Sprintf("Fewer than %d GPUs allocatable on the other ready nodes, reboot deferred",
+			r.Config.SpareCapacity.MinSpareGPUs),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return ctrl.Result{RequeueAfter: getNextRequeueDelay(0)}
+}
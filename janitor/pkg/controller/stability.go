@@ -0,0 +1,167 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+// verifyingStabilityReason is the NodeReady reason of a reboot waiting for the node to stay ready
+const verifyingStabilityReason = "VerifyingStability"
+
+// isVerifyingStability returns true if the node was found ready in the current reboot attempt and the
+// reboot waits for it to stay ready for the post-success verify delay
+func isVerifyingStability(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	nodeReady := meta.FindStatusCondition(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+
+	return nodeReady != nil &&
+		nodeReady.Status == metav1.ConditionUnknown &&
+		nodeReady.Reason == verifyingStabilityReason &&
+		!nodeReady.LastTransitionTime.Before(rebootNode.Status.StartTime)
+}
+
+// shouldVerifyStability returns true if a node found ready must still stay ready for the post-success
+// verify delay before the reboot succeeds
+func (r *RebootNodeReconciler) shouldVerifyStability(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if r.Config == nil || r.Config.PostSuccessVerifyDelay <= 0 {
+		return false
+	}
+
+	if !isVerifyingStability(rebootNode) {
+		return true
+	}
+
+	nodeReady := meta.FindStatusCondition(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+
+	return time.Since(nodeReady.LastTransitionTime.Time) < r.Config.PostSuccessVerifyDelay
+}
+
+// verifyStability records that the node was found ready and requeues the reboot for one more readiness
+// check once the post-success verify delay has elapsed. The delay does not count towards the retry limit.
+func (r *RebootNodeReconciler) verifyStability(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	delay := r.Config.PostSuccessVerifyDelay
+
+	if !isVerifyingStability(rebootNode) {
+		log.FromContext(ctx).Info("node reached ready state post-reboot, verifying it stays ready",
+			"node", node.Name,
+			"delay", delay)
+
+		rebootNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+			Status:             metav1.ConditionUnknown,
+			Reason:             verifyingStabilityReason,
+			Message:            fmt.Sprintf("Node reached ready state post-reboot, verifying it stays ready for %s", delay),
+			LastTransitionTime: metav1.Now(),
+		})
+
+		return requeueUncounted(rebootNode, delay)
+	}
+
+	nodeReady := meta.FindStatusCondition(rebootNode.Status.Conditions, janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady)
+
+	return requeueUncounted(rebootNode, max(delay-time.Since(nodeReady.LastTransitionTime.Time), time.Second))
+}
+
+// failFlappedReboot fails a reboot whose node stopped being ready while its stability was verified
+func (r *RebootNodeReconciler) failFlappedReboot(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	log.FromContext(ctx).Error(nil, "node stopped being ready shortly after the reboot",
+		"node", node.Name,
+		"delay", r.Config.PostSuccessVerifyDelay)
+
+	rebootNode.SetCompletionTime()
+	rebootNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.RebootNodeConditionNodeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NodeFlapped",
+		Message:            fmt.Sprintf("Node reached ready state post-reboot but did not stay ready for %s", r.Config.PostSuccessVerifyDelay),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReboot, metrics.StatusFailed, node.Name, node.Labels)
+
+	return ctrl.Result{}
+}
+
+// trackReadySince records since when the node has been found ready in every check of the reboot, or
+// clears it when the node is not ready, so that a flap restarts the ready stability window. Nothing is
+// recorded while the window is disabled.
+func (r *RebootNodeReconciler) trackReadySince(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	ready bool,
+) {
+	if r.Config == nil || r.Config.ReadyStabilityWindow <= 0 {
+		return
+	}
+
+	switch {
+	case ready && rebootNode.Status.ReadySince == nil:
+		now := metav1.Now()
+		rebootNode.Status.ReadySince = &now
+	case !ready && rebootNode.Status.ReadySince != nil:
+		log.FromContext(ctx).Info("node stopped being ready post-reboot, restarting the ready stability window",
+			"node", rebootNode.Spec.NodeName,
+			"readySince", rebootNode.Status.ReadySince.Time)
+
+		rebootNode.Status.ReadySince = nil
+	}
+}
+
+// shouldWaitForReadyWindow returns true if the node has not been found ready for the whole ready
+// stability window yet
+func (r *RebootNodeReconciler) shouldWaitForReadyWindow(rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode) bool {
+	if r.Config == nil || r.Config.ReadyStabilityWindow <= 0 {
+		return false
+	}
+
+	return rebootNode.Status.ReadySince == nil ||
+		time.Since(rebootNode.Status.ReadySince.Time) < r.Config.ReadyStabilityWindow
+}
+
+// waitForReadyWindow requeues a ready node for the next check within the ready stability window, or
+// for the end of the window, whichever comes first. The window does not count towards the retry limit.
+func (r *RebootNodeReconciler) waitForReadyWindow(
+	ctx context.Context,
+	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+	node *corev1.Node,
+) ctrl.Result {
+	remaining := r.Config.ReadyStabilityWindow - time.Since(rebootNode.Status.ReadySince.Time)
+
+	log.FromContext(ctx).V(1).Info("node is ready post-reboot, waiting for it to stay ready",
+		"node", node.Name,
+		"readySince", rebootNode.Status.ReadySince.Time,
+		"remaining", remaining)
+
+	return requeueUncounted(rebootNode, max(min(remaining, getNextRequeueDelay(0)), time.Second))
+}
@@ -16,14 +16,25 @@ package controller
 
 import (
 	"context"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// StatusFieldManager is the field manager that owns the node action statuses written with server-side apply
+const StatusFieldManager = "janitor"
+
 // NodeActionStatus defines the interface that both RebootNodeStatus and TerminateNodeStatus must implement.
 // This allows generic status update handling across different node action types.
 type NodeActionStatus interface {
@@ -32,6 +43,8 @@ type NodeActionStatus interface {
 	GetStartTime() *metav1.Time
 	GetCompletionTime() *metav1.Time
 	GetConditions() []metav1.Condition
+	// Trim bounds the status lists so the object stays under the etcd size limit
+	Trim()
 }
 
 // NodeActionObject defines the interface that both RebootNode and TerminateNode must implement.
@@ -46,6 +59,32 @@ type NodeActionSpec interface {
 	GetNodeName() string
 }
 
+// statusEquality compares node action statuses the way they are persisted. Times are compared at the
+// second precision of their serialized form, so monotonic clock readings, locations and sub-second
+// differences are ignored, and conditions are compared with conditionsChanged.
+var statusEquality = newStatusEquality()
+
+func newStatusEquality() conversion.Equalities {
+	e := equality.Semantic.Copy()
+
+	utilruntime.Must(e.AddFuncs(
+		func(a, b metav1.Time) bool {
+			return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+		},
+		func(a, b []metav1.Condition) bool {
+			return !conditionsChanged(a, b)
+		},
+	))
+
+	return e
+}
+
+// statusEqual returns true if the two statuses would be persisted the same, comparing every status
+// field with statusEquality
+func statusEqual(original, updated NodeActionStatus) bool {
+	return statusEquality.DeepEqual(original, updated)
+}
+
 // conditionsChanged compares two slices of conditions and returns true if they differ.
 // It checks for differences in Type, Status, Reason, and Message fields.
 func conditionsChanged(original, updated []metav1.Condition) bool {
@@ -83,9 +122,12 @@ func conditionsChanged(original, updated []metav1.Condition) bool {
 //
 // This status update is safe because controller-runtime uses leader election to ensure only one
 // controller instance is active at a time, even with multiple replicas. The active controller
-// has exclusive write access to the resource status.
+// has exclusive write access to the resource status, so an update conflicting with a write to the
+// rest of the object is retried with the fresh resource version read from reader. An object deleted
+// before its status is written is assumed gone: no error is returned and it is not requeued.
 func updateNodeActionStatus[T client.Object](
 	ctx context.Context,
+	reader client.Reader,
 	statusWriter client.SubResourceWriter,
 	original T,
 	updated T,
@@ -97,15 +139,10 @@ func updateNodeActionStatus[T client.Object](
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Check if status changed by comparing individual fields
-	statusChanged := originalStatus.GetRetryCount() != updatedStatus.GetRetryCount() ||
-		originalStatus.GetConsecutiveFailures() != updatedStatus.GetConsecutiveFailures() ||
-		(originalStatus.GetStartTime() == nil) != (updatedStatus.GetStartTime() == nil) ||
-		(originalStatus.GetCompletionTime() == nil) != (updatedStatus.GetCompletionTime() == nil) ||
-		conditionsChanged(originalStatus.GetConditions(), updatedStatus.GetConditions())
+	updatedStatus.Trim()
 
-	if statusChanged {
-		if err := statusWriter.Update(ctx, updated); err != nil {
+	if !statusEqual(originalStatus, updatedStatus) {
+		if err := updateStatusRetryingOnConflict(ctx, reader, statusWriter, updated); err != nil {
 			if apierrors.IsNotFound(err) {
 				logger.V(0).Info("post-reconciliation status update: object not found, assumed deleted",
 					"type", resourceType)
@@ -129,3 +166,76 @@ func updateNodeActionStatus[T client.Object](
 
 	return result, nil
 }
+
+// updateStatusRetryingOnConflict updates the status of obj, retrying a conflicting update with the
+// resource version of a fresh Get. Only conflicts are retried; a NotFound from the update or the Get
+// is returned as is.
+func updateStatusRetryingOnConflict(
+	ctx context.Context,
+	reader client.Reader,
+	statusWriter client.SubResourceWriter,
+	obj client.Object,
+) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		err := statusWriter.Update(ctx, obj)
+		if !apierrors.IsConflict(err) {
+			return err
+		}
+
+		fresh, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			return err
+		}
+
+		if getErr := reader.Get(ctx, client.ObjectKeyFromObject(obj), fresh); getErr != nil {
+			return getErr
+		}
+
+		obj.SetResourceVersion(fresh.GetResourceVersion())
+
+		return err
+	})
+}
+
+// applyStatusWriter is a status writer whose updates are server-side applies of the status under
+// StatusFieldManager. The apply carries no resource version, so it does not conflict with writes
+// made since the object was read, and forcing ownership takes over fields written by updates.
+type applyStatusWriter struct {
+	client.SubResourceWriter
+
+	scheme *runtime.Scheme
+}
+
+// Update applies the status of obj
+func (w applyStatusWriter) Update(ctx context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	applied, err := statusApplyObject(obj, w.scheme)
+	if err != nil {
+		return err
+	}
+
+	return w.Patch(ctx, applied, client.Apply, client.FieldOwner(StatusFieldManager), client.ForceOwnership)
+}
+
+// statusApplyObject returns an apply configuration of obj holding only its identity and status
+func statusApplyObject(obj client.Object, scheme *runtime.Scheme) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := &unstructured.Unstructured{Object: map[string]any{}}
+	applied.SetGroupVersionKind(gvk)
+	applied.SetName(obj.GetName())
+	applied.SetNamespace(obj.GetNamespace())
+
+	if status, ok := content["status"]; ok {
+		applied.Object["status"] = status
+	}
+
+	return applied, nil
+}
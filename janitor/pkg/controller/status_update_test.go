@@ -0,0 +1,150 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+)
+
+// newStatusUpdateClient returns a fake client whose RebootNode status updates call onUpdate before
+// being applied, and a counter of those status updates. onUpdate may return an error to fail the update.
+func newStatusUpdateClient(t *testing.T, onUpdate func(c client.Client, calls int) error,
+	objs ...client.Object) (client.Client, *int) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, janitordgxcnvidiacomv1alpha1.AddToScheme(scheme))
+
+	updateCalls := 0
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+				opts ...client.SubResourceUpdateOption) error {
+				updateCalls++
+
+				if err := onUpdate(c, updateCalls); err != nil {
+					return err
+				}
+
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	return c, &updateCalls
+}
+
+// updateStartedRebootNode reads the RebootNode and writes it back with a start time set
+func updateStartedRebootNode(ctx context.Context, c client.Client, name string) (ctrl.Result, error) {
+	var original janitordgxcnvidiacomv1alpha1.RebootNode
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, &original); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	updated := original.DeepCopy()
+	updated.SetStartTime()
+
+	return updateNodeActionStatus(ctx, c, c.Status(), &original, updated, &original.Status, &updated.Status,
+		updated.Spec.NodeName, "rebootnode", ctrl.Result{RequeueAfter: time.Minute})
+}
+
+func TestUpdateNodeActionStatus_NotFoundOnUpdate(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "deleted-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, updateCalls := newStatusUpdateClient(t, func(client.Client, int) error {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "rebootnodes"}, rebootNode.Name)
+	}, rebootNode)
+
+	result, err := updateStartedRebootNode(context.Background(), c, rebootNode.Name)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result, "a deleted object is not requeued")
+	assert.Equal(t, 1, *updateCalls, "NotFound is not retried")
+}
+
+func TestUpdateNodeActionStatus_RetriesOnConflict(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	// Another writer labels the object before the first status update, so its resource version is stale
+	c, updateCalls := newStatusUpdateClient(t, func(c client.Client, calls int) error {
+		if calls > 1 {
+			return nil
+		}
+
+		var current janitordgxcnvidiacomv1alpha1.RebootNode
+		if err := c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &current); err != nil {
+			return err
+		}
+
+		current.Labels = map[string]string{"touched": "true"}
+
+		return c.Update(context.Background(), &current)
+	}, rebootNode)
+
+	result, err := updateStartedRebootNode(context.Background(), c, rebootNode.Name)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: time.Minute}, result)
+	assert.Equal(t, 2, *updateCalls, "first update conflicts, second succeeds")
+
+	var updated janitordgxcnvidiacomv1alpha1.RebootNode
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: rebootNode.Name}, &updated))
+	assert.NotNil(t, updated.Status.StartTime)
+	assert.Equal(t, "true", updated.Labels["touched"])
+}
+
+func TestUpdateNodeActionStatus_DeletedAfterConflict(t *testing.T) {
+	rebootNode := &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "conflict-deleted-rebootnode"},
+		Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: "test-node"},
+	}
+
+	c, updateCalls := newStatusUpdateClient(t, func(c client.Client, _ int) error {
+		if err := c.Delete(context.Background(), rebootNode.DeepCopy()); err != nil {
+			return err
+		}
+
+		return apierrors.NewConflict(schema.GroupResource{Resource: "rebootnodes"}, rebootNode.Name, assert.AnError)
+	}, rebootNode)
+
+	result, err := updateStartedRebootNode(context.Background(), c, rebootNode.Name)
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result, "a deleted object is not requeued")
+	assert.Equal(t, 1, *updateCalls, "the refreshing Get finds the object gone")
+}
@@ -51,10 +51,14 @@ type TerminateNodeReconciler struct {
 	Scheme    *runtime.Scheme
 	Config    *config.TerminateNodeControllerConfig
 	CSPClient model.CSPClient
+
+	// cspProvider names the CSP in errors returned by CSPClient calls
+	cspProvider string
 }
 
 // updateTerminateNodeStatus is a helper function that handles status updates with proper error handling.
-// It delegates to the generic updateNodeActionStatus function.
+// It delegates to the generic updateNodeActionStatus function and records the drain duration once the
+// status marking the drain completed is written.
 func (r *TerminateNodeReconciler) updateTerminateNodeStatus(
 	ctx context.Context,
 	req ctrl.Request,
@@ -62,8 +66,9 @@ func (r *TerminateNodeReconciler) updateTerminateNodeStatus(
 	updated *janitordgxcnvidiacomv1alpha1.TerminateNode,
 	result ctrl.Result,
 ) (ctrl.Result, error) {
-	return updateNodeActionStatus(
+	result, err := updateNodeActionStatus(
 		ctx,
+		r.Client,
 		r.Status(),
 		original,
 		updated,
@@ -73,16 +78,35 @@ func (r *TerminateNodeReconciler) updateTerminateNodeStatus(
 		"terminatenode",
 		result,
 	)
+	if err == nil && original.Status.DrainCompletedTime == nil && updated.Status.DrainCompletedTime != nil {
+		metrics.GlobalMetrics.RecordDrainDuration(metrics.DrainOutcomeCompleted,
+			updated.Status.DrainCompletedTime.Sub(updated.Status.StartTime.Time))
+	}
+
+	return result, err
 }
 
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=terminatenodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=terminatenodes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=terminatenodes/finalizers,verbs=update
-// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods/eviction,verbs=create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
+// The duration and result of every reconcile are recorded as metrics.
 func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.reconcile(ctx, req)
+
+	metrics.GlobalMetrics.RecordReconcileDuration(metrics.ActionTypeTerminate, reconcileResult(result, err), time.Since(start))
+
+	return result, err
+}
+
+// reconcile performs a single reconciliation of the TerminateNode object
+func (r *TerminateNodeReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Get the TerminateNode object
@@ -101,9 +125,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			// Best effort: log the state for audit trail
 			// Future enhancement: Could add CSP cancellation API call here if available
 
-			controllerutil.RemoveFinalizer(&terminateNode, TerminateNodeFinalizer)
-
-			if err := r.Update(ctx, &terminateNode); err != nil {
+			if err := removeFinalizer(ctx, r.Client, &terminateNode, TerminateNodeFinalizer); err != nil {
 				return ctrl.Result{}, err
 			}
 		}
@@ -113,9 +135,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	// Add finalizer if not present
 	if !controllerutil.ContainsFinalizer(&terminateNode, TerminateNodeFinalizer) {
-		controllerutil.AddFinalizer(&terminateNode, TerminateNodeFinalizer)
-
-		if err := r.Update(ctx, &terminateNode); err != nil {
+		if err := addFinalizer(ctx, r.Client, &terminateNode, TerminateNodeFinalizer); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
@@ -155,7 +175,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			LastTransitionTime: metav1.Now(),
 		})
 
-		metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, terminateNode.Spec.NodeName)
+		metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, terminateNode.Spec.NodeName, nil)
 
 		result = ctrl.Result{} // Don't requeue
 
@@ -202,8 +222,8 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			})
 
 			// Record successful termination metrics
-			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusSucceeded, terminateNode.Spec.NodeName)
-			metrics.RecordActionMTTR(metrics.ActionTypeTerminate, time.Since(terminateNode.Status.StartTime.Time))
+			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusSucceeded, terminateNode.Spec.NodeName, nil)
+			metrics.RecordActionMTTR(metrics.ActionTypeTerminate, nil, time.Since(terminateNode.Status.StartTime.Time))
 
 			result = ctrl.Result{} // Don't requeue on success
 		case isNodeNotReady(&node):
@@ -232,8 +252,8 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			})
 
 			// Record successful termination metrics
-			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusSucceeded, node.Name)
-			metrics.RecordActionMTTR(metrics.ActionTypeTerminate, time.Since(terminateNode.Status.StartTime.Time))
+			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusSucceeded, node.Name, node.Labels)
+			metrics.RecordActionMTTR(metrics.ActionTypeTerminate, node.Labels, time.Since(terminateNode.Status.StartTime.Time))
 
 			result = ctrl.Result{} // Don't requeue on success
 		case time.Since(terminateNode.Status.StartTime.Time) > r.getTerminateTimeout():
@@ -253,7 +273,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 				LastTransitionTime: metav1.Now(),
 			})
 
-			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name)
+			metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name, node.Labels)
 
 			result = ctrl.Result{} // Don't requeue on timeout
 		default:
@@ -310,7 +330,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 						Message:            "Janitor is in manual mode, outside actor required to send terminate signal",
 						LastTransitionTime: now,
 					})
-					metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusStarted, node.Name)
+					metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusStarted, node.Name, node.Labels)
 				}
 
 				logger.Info("manual mode enabled, janitor will not send terminate signal",
@@ -318,17 +338,33 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 				result = ctrl.Result{}
 			} else {
+				// Cordon and drain the node before handing it to the CSP
+				drained, err := r.prepareNodeForTermination(ctx, &terminateNode, &node)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+
+				if !drained {
+					if terminateNode.Status.CompletionTime == nil {
+						delay := getNextRequeueDelay(terminateNode.Status.ConsecutiveFailures)
+						result = ctrl.Result{RequeueAfter: delay}
+					}
+
+					return r.updateTerminateNodeStatus(ctx, req, originalTerminateNode, &terminateNode, result)
+				}
+
 				// Send terminate signal via CSP
 				logger.Info("sending terminate signal to node",
 					"node", terminateNode.Spec.NodeName)
 
-				metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusStarted, node.Name)
+				metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusStarted, node.Name, node.Labels)
 
 				// Add timeout to CSP operation
 				cspCtx, cancel := context.WithTimeout(ctx, CSPOperationTimeout)
 				defer cancel()
 
 				_, terminateErr := r.CSPClient.SendTerminateSignal(cspCtx, node)
+				terminateErr = model.NewCSPError(r.cspProvider, "SendTerminateSignal", node.Name, "", terminateErr)
 
 				// Check for timeout
 				if errors.Is(terminateErr, context.DeadlineExceeded) {
@@ -376,7 +412,7 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 					// Don't requeue on failure
 					result = ctrl.Result{}
 
-					metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name)
+					metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name, node.Labels)
 				}
 
 				terminateNode.SetCondition(signalSentCondition)
@@ -388,6 +424,71 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return r.updateTerminateNodeStatus(ctx, req, originalTerminateNode, &terminateNode, result)
 }
 
+// prepareNodeForTermination cordons the node and evicts its pods. It returns true once the evicted pods
+// are gone and the node is ready to be handed to the CSP. Pods blocked from eviction are retried, and
+// terminating pods waited for, on later reconciles until the terminate timeout, at which point the
+// terminate is marked as failed. Force skips the drain.
+func (r *TerminateNodeReconciler) prepareNodeForTermination(
+	ctx context.Context,
+	terminateNode *janitordgxcnvidiacomv1alpha1.TerminateNode,
+	node *corev1.Node,
+) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	if err := cordonNode(ctx, r.Client, node); err != nil {
+		return false, err
+	}
+
+	if terminateNode.Spec.Force {
+		return true, nil
+	}
+
+	pending, err := drainNode(ctx, r.Client, node.Name)
+	if err != nil {
+		return false, err
+	}
+
+	if pending == 0 {
+		// A drain repeated to retry a timed out terminate signal keeps the first completion
+		if terminateNode.Status.DrainCompletedTime == nil {
+			now := metav1.Now()
+			terminateNode.Status.DrainCompletedTime = &now
+		}
+
+		return true, nil
+	}
+
+	terminateNode.Status.RetryCount++
+
+	if time.Since(terminateNode.Status.StartTime.Time) > r.getTerminateTimeout() {
+		logger.Error(nil, "node drain timed out",
+			"node", node.Name,
+			"pendingPods", pending,
+			"timeout", r.getTerminateTimeout())
+
+		terminateNode.SetCompletionTime()
+		terminateNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionNodeTerminated,
+			Status:             metav1.ConditionFalse,
+			Reason:             "DrainTimeout",
+			Message:            fmt.Sprintf("%d pods did not leave the node before the timeout", pending),
+			LastTransitionTime: metav1.Now(),
+		})
+
+		metrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name, node.Labels)
+		metrics.GlobalMetrics.RecordDrainDuration(metrics.DrainOutcomeTimeout,
+			time.Since(terminateNode.Status.StartTime.Time))
+
+		return false, nil
+	}
+
+	logger.Info("waiting for pods to leave the node before terminating it",
+		"node", node.Name,
+		"pendingPods", pending)
+
+	return false, nil
+}
+
 // isNodeNotReady returns true if the node is not in Ready state
 func isNodeNotReady(node *corev1.Node) bool {
 	for _, condition := range node.Status.Conditions {
@@ -422,6 +523,21 @@ func (r *TerminateNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("failed to create CSP client: %w", err)
 	}
 
+	provider, err := csp.GetProviderFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to determine CSP provider: %w", err)
+	}
+
+	r.cspProvider = string(provider)
+
+	// Index pods by node so the drain only has to look at pods on the node being terminated
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, podNodeNameField,
+		func(obj client.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}); err != nil {
+		return fmt.Errorf("failed to index pods by node name: %w", err)
+	}
+
 	// Note: We use RequeueAfter in the reconcile loop rather than the controller's
 	// rate limiter because we need per-resource (per-node) backoff based on each
 	// node's individual failure count, not per-controller rate limiting.
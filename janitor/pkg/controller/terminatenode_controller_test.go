@@ -22,14 +22,19 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -258,7 +263,9 @@ var _ = Describe("TerminateNodeReconciler", func() {
 				return false
 			}, timeout, interval).Should(BeTrue())
 
-			// Update node to not ready using Status() subresource
+			// Update node to not ready using Status() subresource. Refresh it first since the
+			// reconciler cordoned it before sending the terminate signal.
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeName}, node)).Should(Succeed())
 			node.Status.Conditions[0].Status = corev1.ConditionFalse
 			Expect(k8sClient.Status().Update(ctx, node)).Should(Succeed())
 
@@ -439,6 +446,158 @@ var _ = Describe("TerminateNodeReconciler", func() {
 		})
 	})
 
+	Context("When the node has pods to drain", func() {
+		var pod *corev1.Pod
+
+		BeforeEach(func() {
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "workload-" + uniqueSuffix,
+					Namespace: "default",
+					Labels:    map[string]string{"app": "workload-" + uniqueSuffix},
+				},
+				Spec: corev1.PodSpec{
+					NodeName:   nodeName,
+					Containers: []corev1.Container{{Name: "workload", Image: "busybox"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+
+			// Pending pods are evicted regardless of PodDisruptionBudgets
+			pod.Status.Phase = corev1.PodRunning
+			Expect(k8sClient.Status().Update(ctx, pod)).Should(Succeed())
+		})
+
+		blockEvictions := func() {
+			minAvailable := intstr.FromInt32(1)
+			pdb := &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "workload-pdb-" + uniqueSuffix,
+					Namespace: "default",
+				},
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					MinAvailable: &minAvailable,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "workload-" + uniqueSuffix},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pdb)).Should(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, pdb)).Should(Succeed())
+			})
+		}
+
+		It("Should cordon the node and wait for its evicted pods to exit before sending the terminate signal", func() {
+			completedDrains := drainObservations(metrics.DrainOutcomeCompleted)
+
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			var updatedNode corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeName}, &updatedNode)).Should(Succeed())
+			Expect(updatedNode.Spec.Unschedulable).To(BeTrue())
+
+			// Without a kubelet the evicted pod stays terminating for its grace period
+			var evictedPod corev1.Pod
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace},
+				&evictedPod)).Should(Succeed())
+			Expect(evictedPod.DeletionTimestamp).NotTo(BeNil())
+			Expect(mockCSPClient.terminateSignalSent).To(BeFalse())
+
+			_, err = reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSPClient.terminateSignalSent).To(BeFalse())
+
+			Expect(k8sClient.Delete(ctx, &evictedPod, client.GracePeriodSeconds(0))).Should(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}, &evictedPod)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+
+			_, err = reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockCSPClient.terminateSignalSent).To(BeTrue())
+
+			Expect(drainObservations(metrics.DrainOutcomeCompleted)).To(Equal(completedDrains + 1))
+		})
+
+		It("Should wait without sending the terminate signal while evictions are blocked", func() {
+			blockEvictions()
+
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			Expect(mockCSPClient.terminateSignalSent).To(BeFalse())
+
+			var updatedTerminateNode janitordgxcnvidiacomv1alpha1.TerminateNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: crName}, &updatedTerminateNode)).Should(Succeed())
+			Expect(updatedTerminateNode.Status.CompletionTime).To(BeNil())
+			Expect(updatedTerminateNode.IsTerminateInProgress()).To(BeFalse())
+		})
+
+		It("Should fail the terminate when the drain does not finish before the timeout", func() {
+			blockEvictions()
+			reconciler.Config.Timeout = time.Second * 1
+			timedOutDrains := drainObservations(metrics.DrainOutcomeTimeout)
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			time.Sleep(reconciler.Config.Timeout + time.Second*1)
+
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			Expect(mockCSPClient.terminateSignalSent).To(BeFalse())
+
+			var updatedTerminateNode janitordgxcnvidiacomv1alpha1.TerminateNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: crName}, &updatedTerminateNode)).Should(Succeed())
+			Expect(updatedTerminateNode.Status.CompletionTime).NotTo(BeNil())
+
+			condition := findCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionNodeTerminated)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DrainTimeout"))
+
+			Expect(drainObservations(metrics.DrainOutcomeTimeout)).To(Equal(timedOutDrains + 1))
+		})
+
+		It("Should skip the drain when force is set", func() {
+			blockEvictions()
+
+			terminateNode.Spec.Force = true
+			Expect(k8sClient.Update(ctx, terminateNode)).Should(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: crName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockCSPClient.terminateSignalSent).To(BeTrue())
+
+			var updatedNode corev1.Node
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeName}, &updatedNode)).Should(Succeed())
+			Expect(updatedNode.Spec.Unschedulable).To(BeTrue())
+		})
+	})
+
 	Context("when manual mode is enabled", func() {
 		BeforeEach(func() {
 			// Enable manual mode in the reconciler config
@@ -606,3 +765,25 @@ func findTerminateCondition(conditions []metav1.Condition, conditionType string)
 	}
 	return nil
 }
+
+// drainObservations returns the number of drain durations recorded with the outcome
+func drainObservations(outcome string) uint64 {
+	families, err := ctrlmetrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != "janitor_drain_duration_seconds" {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			for _, pair := range m.GetLabel() {
+				if pair.GetName() == "outcome" && pair.GetValue() == outcome {
+					return m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+
+	return 0
+}
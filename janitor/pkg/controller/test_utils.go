@@ -42,6 +42,14 @@ func (m *MockCSPClient) SendTerminateSignal(
 	return model.TerminateNodeRequestRef(""), m.terminateError
 }
 
+func (m *MockCSPClient) IsRebootComplete(
+	ctx context.Context,
+	node corev1.Node,
+	reqRef string,
+) (bool, error) {
+	return true, nil
+}
+
 func (m *MockCSPClient) IsNodeReady(
 	ctx context.Context,
 	node corev1.Node,
@@ -53,6 +61,7 @@ func (m *MockCSPClient) IsNodeReady(
 func (m *MockCSPClient) SendRebootSignal(
 	ctx context.Context,
 	node corev1.Node,
+	opts model.RebootOptions,
 ) (model.ResetSignalRequestRef, error) {
 	return model.ResetSignalRequestRef(""), nil
 }
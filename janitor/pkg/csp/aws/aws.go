@@ -88,7 +88,12 @@ func WithEC2Client(ctx context.Context) ClientOptionFunc {
 }
 
 // SendRebootSignal sends a reboot signal to AWS EC2 for the given node.
-func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+// EC2 offers a single reboot action, so soft and hard reboots are handled the same way.
+func (c *Client) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
 	logger := log.FromContext(ctx)
 
 	// Fetch the node's provider ID
@@ -109,7 +114,8 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	}
 
 	// Reboot the EC2 instance
-	logger.Info(fmt.Sprintf("Rebooting node %s (Instance ID: %s)", node.Name, instanceID))
+	logger.Info(fmt.Sprintf("Rebooting node %s (Instance ID: %s)", node.Name, instanceID),
+		"rebootType", opts.RebootType)
 
 	_, err = c.ec2.RebootInstances(ctx, &ec2.RebootInstancesInput{
 		InstanceIds: []string{instanceID},
@@ -123,6 +129,11 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(time.Now().Format(time.RFC3339)), nil
 }
 
+// IsRebootComplete always returns true since RebootInstances does not return an operation to wait on.
+func (c *Client) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return true, nil
+}
+
 // IsNodeReady checks if the node is ready after a reboot signal was sent.
 // AWS requires a 5-minute cooldown period before the node status is reliable.
 func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
@@ -67,7 +67,12 @@ func NewClient(ctx context.Context) (*Client, error) {
 }
 
 // SendRebootSignal sends a reboot signal to Azure for the node.
-func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+// Scale set VMs only support a restart, so soft and hard reboots are handled the same way.
+func (c *Client) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
 	logger := log.FromContext(ctx)
 
 	// Get the Azure client
@@ -103,6 +108,11 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(time.Now().Format(time.RFC3339)), nil
 }
 
+// IsRebootComplete always returns true since the restart poller is not kept as an operation handle.
+func (c *Client) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return true, nil
+}
+
 // IsNodeReady checks if the node is ready after a reboot operation.
 func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
 	logger := log.FromContext(ctx)
@@ -18,11 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	computepb "cloud.google.com/go/compute/apiv1/computepb"
 	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+	"google.golang.org/api/googleapi"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -84,9 +86,14 @@ func getNodeFields(node corev1.Node) (*gcpNodeFields, error) {
 	return reqInfo, nil
 }
 
-// SendRebootSignal resets a GCE node by stopping and starting the instance.
+// SendRebootSignal restarts a GCE node. A soft reboot resets the instance in place, while a hard
+// reboot stops and starts it, which power cycles the VM and may move it to another host.
 // nolint:dupl // Similar code pattern as SendTerminateSignal is expected for CSP operations
-func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+func (c *Client) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
 	logger := log.FromContext(ctx)
 
 	instancesClient, err := compute.NewInstancesRESTClient(ctx)
@@ -105,6 +112,10 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 		return "", err
 	}
 
+	if opts.IsHard() {
+		return stopAndStartInstance(ctx, instancesClient, nodeFields)
+	}
+
 	resetReq := &computepb.ResetInstanceRequest{
 		Instance: nodeFields.instance,
 		Project:  nodeFields.project,
@@ -121,8 +132,46 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(op.Proto().GetName()), nil
 }
 
-// IsNodeReady checks if the node is ready after a reboot operation.
-func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
+// stopAndStartInstance stops the instance, waits for it to stop and starts it again.
+// The returned reference is the start operation, which IsRebootComplete waits on.
+func stopAndStartInstance(
+	ctx context.Context,
+	instancesClient *compute.InstancesClient,
+	nodeFields *gcpNodeFields,
+) (model.ResetSignalRequestRef, error) {
+	logger := log.FromContext(ctx)
+
+	logger.Info(fmt.Sprintf("Stopping %s for hard reboot", nodeFields.instance))
+
+	stopOp, err := instancesClient.Stop(ctx, &computepb.StopInstanceRequest{
+		Instance: nodeFields.instance,
+		Project:  nodeFields.project,
+		Zone:     nodeFields.zone,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := stopOp.Wait(ctx); err != nil {
+		return "", fmt.Errorf("failed waiting for instance %s to stop: %w", nodeFields.instance, err)
+	}
+
+	logger.Info(fmt.Sprintf("Starting %s after hard reboot", nodeFields.instance))
+
+	startOp, err := instancesClient.Start(ctx, &computepb.StartInstanceRequest{
+		Instance: nodeFields.instance,
+		Project:  nodeFields.project,
+		Zone:     nodeFields.zone,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return model.ResetSignalRequestRef(startOp.Proto().GetName()), nil
+}
+
+// IsRebootComplete checks if the zone operation returned by SendRebootSignal is done.
+func (c *Client) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
 	logger := log.FromContext(ctx)
 
 	zoneOperationsClient, err := compute.NewZoneOperationsRESTClient(ctx)
@@ -142,21 +191,55 @@ func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message stri
 	}
 
 	req := &computepb.GetZoneOperationRequest{
-		Operation: message,
+		Operation: reqRef,
 		Project:   nodeFields.project,
 		Zone:      nodeFields.zone,
 	}
 
 	op, err := zoneOperationsClient.Get(ctx, req)
 	if err != nil {
+		// Zone operations are garbage collected some time after they finish
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return false, fmt.Errorf("%w: zone operation %s: %w", model.ErrCSPRequestExpired, reqRef, err)
+		}
+
 		return false, err
 	}
 
-	if *op.Status == computepb.Operation_DONE {
-		return true, nil
+	return op.GetStatus() == computepb.Operation_DONE, nil
+}
+
+// IsNodeReady checks if the instance is running after a reboot operation.
+func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	instancesClient, err := compute.NewInstancesRESTClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if cerr := instancesClient.Close(); cerr != nil {
+			logger.Error(cerr, "failed to close instances client")
+		}
+	}()
+
+	nodeFields, err := getNodeFields(node)
+	if err != nil {
+		return false, err
+	}
+
+	instance, err := instancesClient.Get(ctx, &computepb.GetInstanceRequest{
+		Instance: nodeFields.instance,
+		Project:  nodeFields.project,
+		Zone:     nodeFields.zone,
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return false, nil
+	return instance.GetStatus() == computepb.Instance_RUNNING.String(), nil
 }
 
 // SendTerminateSignal deletes a GCE node.
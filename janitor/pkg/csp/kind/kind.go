@@ -41,7 +41,11 @@ func NewClient(ctx context.Context) (*Client, error) {
 }
 
 // SendRebootSignal simulates sending a reboot signal for a kind node
-func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+func (c *Client) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
 	// nolint:gosec // G404: Using weak random for simulation is acceptable
 	// wait some random time to simulate a real csp (very short for fast tests)
 	time.Sleep(time.Duration(3+rand.IntN(3)) * time.Second)
@@ -49,6 +53,11 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(""), nil
 }
 
+// IsRebootComplete always returns true since the simulated reboot has no CSP operation to wait on
+func (c *Client) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return true, nil
+}
+
 // IsNodeReady checks if the node is ready (simulated with randomness for kind)
 func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
 	// nolint:gosec // G404: Using weak random for simulation is acceptable
@@ -101,10 +101,20 @@ func NewClientFromEnv(ctx context.Context) (*Client, error) {
 }
 
 // SendRebootSignal sends a reboot signal to OCI for the given node.
-func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+// A soft reboot gracefully restarts the instance, while a hard reboot power cycles it.
+func (c *Client) SendRebootSignal(
+	ctx context.Context,
+	node corev1.Node,
+	opts model.RebootOptions,
+) (model.ResetSignalRequestRef, error) {
+	action := core.InstanceActionActionSoftreset
+	if opts.IsHard() {
+		action = core.InstanceActionActionReset
+	}
+
 	_, err := c.compute.InstanceAction(ctx, core.InstanceActionRequest{
 		InstanceId: &node.Spec.ProviderID,
-		Action:     core.InstanceActionActionSoftreset,
+		Action:     action,
 	})
 	if err != nil {
 		return "", err
@@ -113,6 +123,11 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(time.Now().UTC().Format(time.RFC3339)), nil
 }
 
+// IsRebootComplete always returns true since the OCI instance action is not tracked as an operation.
+func (c *Client) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return true, nil
+}
+
 // IsNodeReady checks if the node is ready after a reboot operation.
 func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
 	logger := ctrllog.FromContext(ctx)
@@ -0,0 +1,124 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csp
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+const (
+	// DefaultRetryInitialDelay is the delay before the first in-call retry when none is configured
+	DefaultRetryInitialDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay caps the delay between in-call retries when no cap is configured
+	DefaultRetryMaxDelay = 5 * time.Second
+)
+
+// RetryConfig configures the retries a CSP client makes within a single call
+type RetryConfig struct {
+	// MaxRetries is the number of retries after a transient failure; retries are disabled when zero
+	MaxRetries int
+	// InitialDelay is the delay before the first retry; it doubles with every retry
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between retries
+	MaxDelay time.Duration
+}
+
+// retryingClient retries the idempotent status calls of a CSP client on transient errors, so that
+// a dropped connection does not cost a whole reconcile cycle. SendRebootSignal and SendTerminateSignal
+// are not idempotent and are passed through, leaving their retries to the controller backoff.
+type retryingClient struct {
+	model.CSPClient
+
+	config RetryConfig
+}
+
+// WithRetries wraps client so that IsRebootComplete and IsNodeReady are retried with exponential
+// backoff on transient errors. The client is returned unchanged when retries are disabled.
+func WithRetries(client model.CSPClient, config RetryConfig) model.CSPClient {
+	if config.MaxRetries <= 0 {
+		return client
+	}
+
+	if config.InitialDelay <= 0 {
+		config.InitialDelay = DefaultRetryInitialDelay
+	}
+
+	if config.MaxDelay <= 0 {
+		config.MaxDelay = DefaultRetryMaxDelay
+	}
+
+	return &retryingClient{CSPClient: client, config: config}
+}
+
+// Unwrap returns the wrapped client, e.g. to look up the optional interfaces it implements
+func (c *retryingClient) Unwrap() model.CSPClient {
+	return c.CSPClient
+}
+
+// IsRebootComplete retries the wrapped IsRebootComplete on transient errors
+func (c *retryingClient) IsRebootComplete(
+	ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	return withRetries(ctx, c.config, "IsRebootComplete", node.Name, func() (bool, error) {
+		return c.CSPClient.IsRebootComplete(ctx, node, reqRef)
+	})
+}
+
+// IsNodeReady retries the wrapped IsNodeReady on transient errors
+func (c *retryingClient) IsNodeReady(
+	ctx context.Context, node corev1.Node, message string) (bool, error) {
+	return withRetries(ctx, c.config, "IsNodeReady", node.Name, func() (bool, error) {
+		return c.CSPClient.IsNodeReady(ctx, node, message)
+	})
+}
+
+// withRetries calls fn until it succeeds, fails with an error that is not transient, retries are
+// exhausted or the context is done, and returns the result of the last call
+func withRetries[T any](ctx context.Context, config RetryConfig, operation, node string,
+	fn func() (T, error)) (T, error) {
+	delay := config.InitialDelay
+
+	for attempt := 0; ; attempt++ {
+		result, err := fn()
+		if err == nil || attempt >= config.MaxRetries || !model.IsTransientCSPError(err) {
+			return result, err
+		}
+
+		log.FromContext(ctx).V(1).Info("retrying transient CSP error",
+			"operation", operation,
+			"node", node,
+			"attempt", attempt+1,
+			"delay", delay,
+			"error", err.Error())
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return result, err
+		case <-timer.C:
+		}
+
+		delay = min(delay*2, config.MaxDelay)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csp
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/model"
+)
+
+// flakyCSPClient fails every call with the queued errors before succeeding
+type flakyCSPClient struct {
+	errs  []error
+	calls int
+}
+
+func (f *flakyCSPClient) call() error {
+	f.calls++
+
+	if len(f.errs) == 0 {
+		return nil
+	}
+
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+
+	return err
+}
+
+func (f *flakyCSPClient) SendRebootSignal(
+	ctx context.Context, node corev1.Node, opts model.RebootOptions) (model.ResetSignalRequestRef, error) {
+	return "operation-1", f.call()
+}
+
+func (f *flakyCSPClient) IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error) {
+	err := f.call()
+	return err == nil, err
+}
+
+func (f *flakyCSPClient) IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error) {
+	err := f.call()
+	return err == nil, err
+}
+
+func (f *flakyCSPClient) SendTerminateSignal(
+	ctx context.Context, node corev1.Node) (model.TerminateNodeRequestRef, error) {
+	return "", f.call()
+}
+
+func testRetryConfig() RetryConfig {
+	return RetryConfig{MaxRetries: 2, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+}
+
+func TestWithRetries_RetriesTransientErrorWithinCall(t *testing.T) {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	t.Run("IsNodeReady", func(t *testing.T) {
+		flaky := &flakyCSPClient{errs: []error{syscall.ECONNRESET}}
+		client := WithRetries(flaky, testRetryConfig())
+
+		ready, err := client.IsNodeReady(context.Background(), node, "")
+		require.NoError(t, err)
+		assert.True(t, ready)
+		assert.Equal(t, 2, flaky.calls)
+	})
+
+	t.Run("IsRebootComplete", func(t *testing.T) {
+		flaky := &flakyCSPClient{errs: []error{model.ErrCSPTransient}}
+		client := WithRetries(flaky, testRetryConfig())
+
+		complete, err := client.IsRebootComplete(context.Background(), node, "operation-1")
+		require.NoError(t, err)
+		assert.True(t, complete)
+		assert.Equal(t, 2, flaky.calls)
+	})
+}
+
+func TestWithRetries_GivesUp(t *testing.T) {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		flaky := &flakyCSPClient{errs: []error{syscall.ECONNRESET, syscall.ECONNRESET, syscall.ECONNRESET}}
+		client := WithRetries(flaky, testRetryConfig())
+
+		_, err := client.IsNodeReady(context.Background(), node, "")
+		assert.ErrorIs(t, err, syscall.ECONNRESET)
+		assert.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("error is not transient", func(t *testing.T) {
+		denied := errors.New("access denied")
+		flaky := &flakyCSPClient{errs: []error{denied}}
+		client := WithRetries(flaky, testRetryConfig())
+
+		_, err := client.IsNodeReady(context.Background(), node, "")
+		assert.ErrorIs(t, err, denied)
+		assert.Equal(t, 1, flaky.calls)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		flaky := &flakyCSPClient{errs: []error{syscall.ECONNRESET}}
+		client := WithRetries(flaky, RetryConfig{MaxRetries: 2, InitialDelay: time.Hour})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.IsNodeReady(ctx, node, "")
+		assert.ErrorIs(t, err, syscall.ECONNRESET)
+		assert.Equal(t, 1, flaky.calls)
+	})
+}
+
+func TestWithRetries_DoesNotRetrySignals(t *testing.T) {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	flaky := &flakyCSPClient{errs: []error{syscall.ECONNRESET, syscall.ECONNRESET}}
+	client := WithRetries(flaky, testRetryConfig())
+
+	_, err := client.SendRebootSignal(context.Background(), node, model.RebootOptions{})
+	assert.ErrorIs(t, err, syscall.ECONNRESET)
+
+	_, err = client.SendTerminateSignal(context.Background(), node)
+	assert.ErrorIs(t, err, syscall.ECONNRESET)
+
+	assert.Equal(t, 2, flaky.calls)
+}
+
+// pendingOperationClient is a flaky CSP client that can also report pending operations
+type pendingOperationClient struct {
+	flakyCSPClient
+}
+
+func (p *pendingOperationClient) HasPendingOperation(ctx context.Context, node corev1.Node) (bool, error) {
+	return true, p.call()
+}
+
+func TestWithRetries_ExposesOptionalInterfaces(t *testing.T) {
+	pending := &pendingOperationClient{}
+	client := WithRetries(pending, testRetryConfig())
+
+	checker, ok := model.AsPendingOperationChecker(client)
+	require.True(t, ok)
+	assert.Same(t, pending, checker)
+
+	_, ok = model.AsOperationCanceller(client)
+	assert.False(t, ok)
+
+	_, ok = model.AsPendingOperationChecker(WithRetries(&flakyCSPClient{}, testRetryConfig()))
+	assert.False(t, ok)
+}
+
+func TestWithRetries_Disabled(t *testing.T) {
+	flaky := &flakyCSPClient{}
+
+	assert.Same(t, flaky, WithRetries(flaky, RetryConfig{}))
+}
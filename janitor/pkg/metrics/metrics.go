@@ -15,6 +15,9 @@
 package metrics
 
 import (
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,69 +37,444 @@ const (
 	StatusFailed    = "failed"
 )
 
-var (
-	// actionsCount tracks the total number of actions by type and status
-	actionsCount = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "janitor_actions_count",
-			Help: "Total number of janitor actions by type and status",
-		},
-		[]string{"action_type", "status", "node"},
-	)
+// Result values for reconcile duration metrics
+const (
+	ReconcileResultSuccess = "success"
+	ReconcileResultRequeue = "requeue"
+	ReconcileResultError   = "error"
+)
 
-	// actionMTTRHistogram tracks the time taken to complete actions
-	actionMTTRHistogram = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name:    "janitor_action_mttr_seconds",
-			Help:    "Time taken to complete janitor actions",
-			Buckets: prometheus.ExponentialBuckets(10, 2, 10), // Log-scale buckets for MTTR
-		},
-		[]string{"action_type"},
-	)
+// Outcome values for RebootNode reconcile metrics
+const (
+	// ReconcileOutcomeSignalSent is a reconcile that sent the reboot signal
+	ReconcileOutcomeSignalSent = "signal_sent"
+	// ReconcileOutcomeMonitoring is a reconcile that polled a reboot in progress
+	ReconcileOutcomeMonitoring = "monitoring"
+	// ReconcileOutcomeCompleted is a reconcile that completed the RebootNode without failing it
+	ReconcileOutcomeCompleted = "completed"
+	// ReconcileOutcomeFailed is a reconcile that failed the RebootNode or returned an error
+	ReconcileOutcomeFailed = "failed"
+	// ReconcileOutcomeNoop is a reconcile that neither changed the reboot nor requeued it
+	ReconcileOutcomeNoop = "noop"
+	// ReconcileOutcomeRequeued is a reconcile that requeued a reboot not yet in progress, e.g. while
+	// paused, backing off or waiting for a reboot slot
+	ReconcileOutcomeRequeued = "requeued"
+)
+
+// Phase values for reboot phase duration metrics
+const (
+	// RebootPhaseQueued is the time from the creation of a RebootNode until its reboot signal was sent
+	RebootPhaseQueued = "queued"
+	// RebootPhaseRebooting is the time from the reboot signal until the node was declared ready
+	RebootPhaseRebooting = "rebooting"
+)
+
+// Outcome values for drain duration metrics
+const (
+	DrainOutcomeCompleted = "completed"
+	DrainOutcomeTimeout   = "timeout"
 )
 
+// Phase values for in-flight RebootNode metrics
+const (
+	PhasePending    = "pending"
+	PhaseInProgress = "in_progress"
+	PhaseCompleted  = "completed"
+)
+
+// Node label modes bound the cardinality of the node label of janitor_actions_count
+const (
+	// NodeLabelPerNode records the node of every action
+	NodeLabelPerNode = "PerNode"
+	// NodeLabelNone never records the node, aggregating the actions of all nodes
+	NodeLabelNone = "None"
+	// NodeLabelUnsucceededOnly records the node of started and failed actions only and aggregates
+	// succeeded actions, which make up most of the series in a healthy cluster
+	NodeLabelUnsucceededOnly = "UnsucceededOnly"
+)
+
+// DefaultMTTRBuckets are the action MTTR histogram buckets used unless others are configured:
+// log-scale from 10 seconds to about 85 minutes
+var DefaultMTTRBuckets = prometheus.ExponentialBuckets(10, 2, 10)
+
+// options holds the settings applied by OptionFunc
+type options struct {
+	mttrBuckets   []float64
+	nodeLabelMode string
+	nodePoolLabel string
+}
+
+// OptionFunc is a function that configures the metrics created by NewActionMetricsWithRegisterer.
+type OptionFunc func(*options) error
+
+// WithMTTRBuckets returns an option function that sets the upper bounds, in seconds, of the action
+// MTTR histogram buckets. The bounds must be strictly increasing.
+func WithMTTRBuckets(buckets ...float64) OptionFunc {
+	return func(o *options) error {
+		if len(buckets) == 0 {
+			return errors.New("MTTR buckets must not be empty")
+		}
+
+		for i := 1; i < len(buckets); i++ {
+			if buckets[i] <= buckets[i-1] {
+				return fmt.Errorf("MTTR buckets must be strictly increasing, got %v", buckets)
+			}
+		}
+
+		o.mttrBuckets = buckets
+
+		return nil
+	}
+}
+
+// WithNodeLabelMode returns an option function that sets the node label mode of the action count
+func WithNodeLabelMode(mode string) OptionFunc {
+	return func(o *options) error {
+		if err := validateNodeLabelMode(mode); err != nil {
+			return err
+		}
+
+		o.nodeLabelMode = mode
+
+		return nil
+	}
+}
+
+// WithNodePoolLabel returns an option function that sets the node label whose value is copied into
+// the node_pool label of the action count and MTTR, see SetNodePoolLabel
+func WithNodePoolLabel(key string) OptionFunc {
+	return func(o *options) error {
+		o.nodePoolLabel = key
+
+		return nil
+	}
+}
+
+// validateNodeLabelMode fails for anything but NodeLabelPerNode, NodeLabelNone or NodeLabelUnsucceededOnly
+func validateNodeLabelMode(mode string) error {
+	switch mode {
+	case NodeLabelPerNode, NodeLabelNone, NodeLabelUnsucceededOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid metrics node label mode %q: must be %s, %s or %s",
+			mode, NodeLabelPerNode, NodeLabelNone, NodeLabelUnsucceededOnly)
+	}
+}
+
 // ActionMetrics provides a centralized interface for recording action metrics
-type ActionMetrics struct{}
+type ActionMetrics struct {
+	// actionsCount tracks the total number of actions by type and status
+	actionsCount *prometheus.CounterVec
+	// actionMTTRHistogram tracks the time taken to complete actions
+	actionMTTRHistogram *prometheus.HistogramVec
+	// reconcileDuration tracks how long a single reconcile takes by action type and result
+	reconcileDuration *prometheus.HistogramVec
+	// rebootSLABreaches counts reboots that took longer than the configured SLA to complete
+	// It has no labels; it is a vector so that Reset can clear it
+	rebootSLABreaches *prometheus.CounterVec
+	// rebootsAbandoned counts reboots whose RebootNode was deleted before the reboot completed
+	// It has no labels; it is a vector so that Reset can clear it
+	rebootsAbandoned *prometheus.CounterVec
+	// rebootRetriesExhausted counts reboots failed because they ran out of retries
+	// It has no labels; it is a vector so that Reset can clear it
+	rebootRetriesExhausted *prometheus.CounterVec
+	// rebootNodesByPhase tracks the number of RebootNode objects in each phase
+	rebootNodesByPhase *prometheus.GaugeVec
+	// drainDuration tracks how long cordoning a node and evicting its pods takes by outcome
+	drainDuration *prometheus.HistogramVec
+	// reconcileOutcomes counts RebootNode reconciles by the branch they took
+	reconcileOutcomes *prometheus.CounterVec
+	// rebootPhaseDuration tracks how long each phase of a completed reboot took
+	rebootPhaseDuration *prometheus.HistogramVec
+	// nodeLabelMode holds the node label mode of actionsCount
+	nodeLabelMode atomic.Value
+	// nodePoolLabel holds the only node label key copied into the node_pool label
+	nodePoolLabel atomic.Value
+}
 
-// NewActionMetrics creates a new ActionMetrics instance and registers the metrics
+// NewActionMetrics creates a new ActionMetrics instance registered with the controller-runtime
+// metrics registry. It panics when the metrics are already registered there.
 func NewActionMetrics() *ActionMetrics {
-	// Register metrics with the controller-runtime metrics registry
-	metrics.Registry.MustRegister(actionsCount)
-	metrics.Registry.MustRegister(actionMTTRHistogram)
+	m, err := NewActionMetricsWithRegisterer(metrics.Registry)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// NewActionMetricsWithRegisterer creates a new ActionMetrics instance with its own collectors and
+// registers them with registerer, e.g. a prometheus.NewRegistry() in tests. Registering a second
+// instance with the same registerer fails.
+func NewActionMetricsWithRegisterer(registerer prometheus.Registerer, opts ...OptionFunc) (*ActionMetrics, error) {
+	o := &options{mttrBuckets: DefaultMTTRBuckets, nodeLabelMode: NodeLabelPerNode}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &ActionMetrics{
+		actionsCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "janitor_actions_count",
+				Help: "Total number of janitor actions by type and status",
+			},
+			[]string{"action_type", "status", "node", "node_pool"},
+		),
+		actionMTTRHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "janitor_action_mttr_seconds",
+				Help:    "Time taken to complete janitor actions",
+				Buckets: o.mttrBuckets,
+			},
+			[]string{"action_type", "node_pool"},
+		),
+		reconcileDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "janitor_reconcile_duration_seconds",
+				Help:    "Time taken by a single janitor reconcile",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"action_type", "result"},
+		),
+		rebootSLABreaches: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "janitor_reboot_sla_breach_total",
+				Help: "Total number of reboots that did not complete within the configured SLA",
+			},
+			nil,
+		),
+		rebootsAbandoned: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "janitor_reboot_abandoned_total",
+				Help: "Total number of reboots abandoned because their RebootNode was deleted before completion",
+			},
+			nil,
+		),
+		rebootRetriesExhausted: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "janitor_reboot_retries_exhausted_total",
+				Help: "Total number of reboots failed because they exceeded their maximum number of retries",
+			},
+			nil,
+		),
+		rebootNodesByPhase: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "janitor_rebootnodes",
+				Help: "Number of RebootNode objects by phase",
+			},
+			[]string{"phase"},
+		),
+		drainDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "janitor_drain_duration_seconds",
+				Help:    "Time taken to cordon a node and evict its pods before terminating it",
+				Buckets: prometheus.ExponentialBuckets(1, 2, 12), // 1s to about 34 minutes
+			},
+			[]string{"outcome"},
+		),
+		reconcileOutcomes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "janitor_reconcile_total",
+				Help: "Total number of RebootNode reconciles by outcome",
+			},
+			[]string{"outcome"},
+		),
+		rebootPhaseDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "janitor_reboot_phase_duration_seconds",
+				Help:    "Time taken by each phase of a completed reboot",
+				Buckets: o.mttrBuckets,
+			},
+			[]string{"phase"},
+		),
+	}
 
-	return &ActionMetrics{}
+	m.nodeLabelMode.Store(o.nodeLabelMode)
+	m.nodePoolLabel.Store(o.nodePoolLabel)
+
+	collectors := []prometheus.Collector{
+		m.actionsCount,
+		m.actionMTTRHistogram,
+		m.reconcileDuration,
+		m.rebootNodesByPhase,
+		m.rebootSLABreaches,
+		m.rebootsAbandoned,
+		m.rebootRetriesExhausted,
+		m.drainDuration,
+		m.reconcileOutcomes,
+		m.rebootPhaseDuration,
+	}
+
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return nil, fmt.Errorf("failed to register janitor metrics: %w", err)
+		}
+	}
+
+	m.initUnlabeledCounters()
+
+	return m, nil
 }
 
-// IncActionCount increments the action count for the given action type, status, and node
-func (m *ActionMetrics) IncActionCount(actionType, status, node string) {
-	actionsCount.With(prometheus.Labels{
+// IncActionCount increments the action count for the given action type, status, and node.
+// nodeLabels are the labels of the node, if known, from which the node pool is read.
+func (m *ActionMetrics) IncActionCount(actionType, status, node string, nodeLabels map[string]string) {
+	m.actionsCount.With(prometheus.Labels{
 		"action_type": actionType,
 		"status":      status,
-		"node":        node,
+		"node":        m.nodeLabel(status, node),
+		"node_pool":   m.nodePool(nodeLabels),
 	}).Inc()
 }
 
-// RecordActionMTTR records the completion time for an action
-func (m *ActionMetrics) RecordActionMTTR(actionType string, duration time.Duration) {
-	actionMTTRHistogram.With(prometheus.Labels{
+// SetNodeLabelMode changes the node label mode of the action count, e.g. to apply the configuration
+// to GlobalMetrics. Actions already counted keep their series.
+func (m *ActionMetrics) SetNodeLabelMode(mode string) error {
+	if err := validateNodeLabelMode(mode); err != nil {
+		return err
+	}
+
+	m.nodeLabelMode.Store(mode)
+
+	return nil
+}
+
+// SetNodePoolLabel sets the node label whose value is copied into the node_pool label of the action
+// count and MTTR, e.g. to apply the configuration to GlobalMetrics. No other node label is ever
+// copied, which bounds the node_pool cardinality to the number of pools. An empty key disables the
+// node_pool label.
+func (m *ActionMetrics) SetNodePoolLabel(key string) {
+	m.nodePoolLabel.Store(key)
+}
+
+// nodePool returns the node_pool label value of a node with the labels; an empty value, e.g. for a
+// node without the pool label, is the same as no label to Prometheus
+func (m *ActionMetrics) nodePool(nodeLabels map[string]string) string {
+	key, _ := m.nodePoolLabel.Load().(string)
+	if key == "" {
+		return ""
+	}
+
+	return nodeLabels[key]
+}
+
+// nodeLabel returns the node label value of an action with the status under the node label mode;
+// an empty value is the same as no label to Prometheus
+func (m *ActionMetrics) nodeLabel(status, node string) string {
+	switch m.nodeLabelMode.Load() {
+	case NodeLabelNone:
+		return ""
+	case NodeLabelUnsucceededOnly:
+		if status == StatusSucceeded {
+			return ""
+		}
+	}
+
+	return node
+}
+
+// RecordActionMTTR records the completion time for an action. nodeLabels are the labels of the
+// node, if known, from which the node pool is read.
+func (m *ActionMetrics) RecordActionMTTR(actionType string, nodeLabels map[string]string, duration time.Duration) {
+	m.actionMTTRHistogram.With(prometheus.Labels{
 		"action_type": actionType,
+		"node_pool":   m.nodePool(nodeLabels),
+	}).Observe(duration.Seconds())
+}
+
+// RecordReconcileDuration records the duration of a single reconcile and its result
+func (m *ActionMetrics) RecordReconcileDuration(actionType, result string, duration time.Duration) {
+	m.reconcileDuration.With(prometheus.Labels{
+		"action_type": actionType,
+		"result":      result,
+	}).Observe(duration.Seconds())
+}
+
+// SetRebootNodePhaseCount sets the number of RebootNode objects currently in the given phase
+func (m *ActionMetrics) SetRebootNodePhaseCount(phase string, count int) {
+	m.rebootNodesByPhase.With(prometheus.Labels{
+		"phase": phase,
+	}).Set(float64(count))
+}
+
+// RecordDrainDuration records how long a node drain took until it completed or timed out
+func (m *ActionMetrics) RecordDrainDuration(outcome string, duration time.Duration) {
+	m.drainDuration.With(prometheus.Labels{
+		"outcome": outcome,
 	}).Observe(duration.Seconds())
 }
 
+// IncReconcileOutcome counts a RebootNode reconcile with the given outcome
+func (m *ActionMetrics) IncReconcileOutcome(outcome string) {
+	m.reconcileOutcomes.With(prometheus.Labels{
+		"outcome": outcome,
+	}).Inc()
+}
+
+// RecordRebootPhaseDuration records how long a phase of a completed reboot took
+func (m *ActionMetrics) RecordRebootPhaseDuration(phase string, duration time.Duration) {
+	m.rebootPhaseDuration.With(prometheus.Labels{
+		"phase": phase,
+	}).Observe(duration.Seconds())
+}
+
+// IncRebootSLABreach counts a reboot that did not complete within the SLA
+func (m *ActionMetrics) IncRebootSLABreach() {
+	m.rebootSLABreaches.WithLabelValues().Inc()
+}
+
+// IncRebootAbandoned counts a reboot abandoned because its RebootNode was deleted mid-flight
+func (m *ActionMetrics) IncRebootAbandoned() {
+	m.rebootsAbandoned.WithLabelValues().Inc()
+}
+
+// IncRebootRetriesExhausted counts a reboot failed because it exceeded its maximum number of retries
+func (m *ActionMetrics) IncRebootRetriesExhausted() {
+	m.rebootRetriesExhausted.WithLabelValues().Inc()
+}
+
+// Reset clears every metric recorded by m, so that tests sharing an instance such as GlobalMetrics
+// can assert exact values. Labeled metrics are reported again once recorded after the reset; the
+// unlabeled counters are reported at zero right away.
+func (m *ActionMetrics) Reset() {
+	m.actionsCount.Reset()
+	m.actionMTTRHistogram.Reset()
+	m.reconcileDuration.Reset()
+	m.rebootNodesByPhase.Reset()
+	m.rebootSLABreaches.Reset()
+	m.rebootsAbandoned.Reset()
+	m.rebootRetriesExhausted.Reset()
+	m.drainDuration.Reset()
+	m.reconcileOutcomes.Reset()
+	m.rebootPhaseDuration.Reset()
+
+	m.initUnlabeledCounters()
+}
+
+// initUnlabeledCounters creates the single series of the unlabeled counters, so that they are reported
+// at zero before their first increment like plain counters
+func (m *ActionMetrics) initUnlabeledCounters() {
+	m.rebootSLABreaches.WithLabelValues()
+	m.rebootsAbandoned.WithLabelValues()
+	m.rebootRetriesExhausted.WithLabelValues()
+}
+
 // GlobalMetrics is the global metrics instance for easy access across controllers
 var GlobalMetrics *ActionMetrics
 
-// Initialize the global metrics instance
+// Initialize the global metrics instance with the controller-runtime metrics registry
 func init() {
 	GlobalMetrics = NewActionMetrics()
 }
 
 // IncActionCount is a convenience function to increment action count using the global instance
-func IncActionCount(actionType, status, node string) {
-	GlobalMetrics.IncActionCount(actionType, status, node)
+func IncActionCount(actionType, status, node string, nodeLabels map[string]string) {
+	GlobalMetrics.IncActionCount(actionType, status, node, nodeLabels)
 }
 
 // RecordActionMTTR is a convenience function to record MTTR using the global instance
-func RecordActionMTTR(actionType string, duration time.Duration) {
-	GlobalMetrics.RecordActionMTTR(actionType, duration)
+func RecordActionMTTR(actionType string, nodeLabels map[string]string, duration time.Duration) {
+	GlobalMetrics.RecordActionMTTR(actionType, nodeLabels, duration)
 }
@@ -15,21 +15,69 @@
 package metrics
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// newTestMetrics returns metrics registered with a registry of their own
+func newTestMetrics(t *testing.T) *ActionMetrics {
+	t.Helper()
+
+	m, err := NewActionMetricsWithRegisterer(prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	return m
+}
+
 func TestNewActionMetrics(t *testing.T) {
 	// Test that GlobalMetrics is already initialized
 	// We can't call NewActionMetrics again due to duplicate registration
 	assert.NotNil(t, GlobalMetrics, "GlobalMetrics should be initialized")
 }
 
+func TestNewActionMetricsWithRegisterer(t *testing.T) {
+	t.Run("separate registries", func(t *testing.T) {
+		first := prometheus.NewRegistry()
+		second := prometheus.NewRegistry()
+
+		m1, err := NewActionMetricsWithRegisterer(first)
+		require.NoError(t, err)
+		m2, err := NewActionMetricsWithRegisterer(second)
+		require.NoError(t, err)
+
+		m1.IncActionCount(ActionTypeReboot, StatusStarted, "node-1", nil)
+
+		assert.Equal(t, 1, testutil.CollectAndCount(m1.actionsCount))
+		assert.Equal(t, 0, testutil.CollectAndCount(m2.actionsCount), "instances must not share collectors")
+
+		families, err := first.Gather()
+		require.NoError(t, err)
+		assert.NotEmpty(t, families)
+	})
+
+	t.Run("duplicate registration", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+
+		_, err := NewActionMetricsWithRegisterer(registry)
+		require.NoError(t, err)
+
+		assert.NotPanics(t, func() {
+			_, err = NewActionMetricsWithRegisterer(registry)
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestActionMetrics_IncActionCount(t *testing.T) {
 	// Create a new metrics instance
-	m := &ActionMetrics{}
+	m := newTestMetrics(t)
 
 	tests := []struct {
 		name       string
@@ -67,7 +115,7 @@ func TestActionMetrics_IncActionCount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Should not panic when incrementing
 			assert.NotPanics(t, func() {
-				m.IncActionCount(tt.actionType, tt.status, tt.node)
+				m.IncActionCount(tt.actionType, tt.status, tt.node, nil)
 			})
 		})
 	}
@@ -75,7 +123,7 @@ func TestActionMetrics_IncActionCount(t *testing.T) {
 
 func TestActionMetrics_RecordActionMTTR(t *testing.T) {
 	// Create a new metrics instance
-	m := &ActionMetrics{}
+	m := newTestMetrics(t)
 
 	tests := []struct {
 		name       string
@@ -103,7 +151,7 @@ func TestActionMetrics_RecordActionMTTR(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Should not panic when recording
 			assert.NotPanics(t, func() {
-				m.RecordActionMTTR(tt.actionType, tt.duration)
+				m.RecordActionMTTR(tt.actionType, nil, tt.duration)
 			})
 		})
 	}
@@ -112,11 +160,11 @@ func TestActionMetrics_RecordActionMTTR(t *testing.T) {
 func TestGlobalMetrics_Functions(t *testing.T) {
 	// Test that global convenience functions work
 	assert.NotPanics(t, func() {
-		IncActionCount(ActionTypeReboot, StatusStarted, "global-test-node")
+		IncActionCount(ActionTypeReboot, StatusStarted, "global-test-node", nil)
 	})
 
 	assert.NotPanics(t, func() {
-		RecordActionMTTR(ActionTypeReboot, 1*time.Minute)
+		RecordActionMTTR(ActionTypeReboot, nil, 1*time.Minute)
 	})
 }
 
@@ -133,12 +181,12 @@ func TestMetricsConstants(t *testing.T) {
 func TestActionMetrics_CounterIncrement(t *testing.T) {
 	t.Run("IncActionCount increments counter", func(t *testing.T) {
 		// Create metrics instance
-		m := &ActionMetrics{}
+		m := newTestMetrics(t)
 
 		// Call the actual business logic
-		m.IncActionCount(ActionTypeReboot, StatusStarted, "test-node-1")
-		m.IncActionCount(ActionTypeReboot, StatusSucceeded, "test-node-1")
-		m.IncActionCount(ActionTypeTerminate, StatusStarted, "test-node-2")
+		m.IncActionCount(ActionTypeReboot, StatusStarted, "test-node-1", nil)
+		m.IncActionCount(ActionTypeReboot, StatusSucceeded, "test-node-1", nil)
+		m.IncActionCount(ActionTypeTerminate, StatusStarted, "test-node-2", nil)
 
 		// Note: We can't easily verify the exact counter values without accessing
 		// the internal prometheus registry, but we can verify the method executes
@@ -148,27 +196,27 @@ func TestActionMetrics_CounterIncrement(t *testing.T) {
 
 	t.Run("global IncActionCount function works", func(t *testing.T) {
 		// Test the convenience function that uses GlobalMetrics
-		IncActionCount(ActionTypeReboot, StatusStarted, "global-test-node")
-		IncActionCount(ActionTypeReboot, StatusSucceeded, "global-test-node")
-		IncActionCount(ActionTypeReboot, StatusFailed, "global-test-node")
+		IncActionCount(ActionTypeReboot, StatusStarted, "global-test-node", nil)
+		IncActionCount(ActionTypeReboot, StatusSucceeded, "global-test-node", nil)
+		IncActionCount(ActionTypeReboot, StatusFailed, "global-test-node", nil)
 
 		// Verify different action types
-		IncActionCount(ActionTypeTerminate, StatusStarted, "global-test-node-2")
+		IncActionCount(ActionTypeTerminate, StatusStarted, "global-test-node-2", nil)
 	})
 }
 
 func TestActionMetrics_HistogramObservation(t *testing.T) {
 	t.Run("RecordActionMTTR records duration", func(t *testing.T) {
 		// Create metrics instance
-		m := &ActionMetrics{}
+		m := newTestMetrics(t)
 
 		// Call the actual business logic with various durations
-		m.RecordActionMTTR(ActionTypeReboot, 30*time.Second)
-		m.RecordActionMTTR(ActionTypeReboot, 2*time.Minute)
-		m.RecordActionMTTR(ActionTypeReboot, 5*time.Minute)
+		m.RecordActionMTTR(ActionTypeReboot, nil, 30*time.Second)
+		m.RecordActionMTTR(ActionTypeReboot, nil, 2*time.Minute)
+		m.RecordActionMTTR(ActionTypeReboot, nil, 5*time.Minute)
 
 		// Record different action types
-		m.RecordActionMTTR(ActionTypeTerminate, 10*time.Minute)
+		m.RecordActionMTTR(ActionTypeTerminate, nil, 10*time.Minute)
 
 		// Note: We can't easily verify the exact histogram values without accessing
 		// the internal prometheus registry, but we can verify the method executes
@@ -178,14 +226,14 @@ func TestActionMetrics_HistogramObservation(t *testing.T) {
 
 	t.Run("global RecordActionMTTR function works", func(t *testing.T) {
 		// Test the convenience function that uses GlobalMetrics
-		RecordActionMTTR(ActionTypeReboot, 45*time.Second)
-		RecordActionMTTR(ActionTypeTerminate, 3*time.Minute)
+		RecordActionMTTR(ActionTypeReboot, nil, 45*time.Second)
+		RecordActionMTTR(ActionTypeTerminate, nil, 3*time.Minute)
 
 		// Test with very short duration
-		RecordActionMTTR(ActionTypeReboot, 5*time.Second)
+		RecordActionMTTR(ActionTypeReboot, nil, 5*time.Second)
 
 		// Test with longer duration
-		RecordActionMTTR(ActionTypeTerminate, 15*time.Minute)
+		RecordActionMTTR(ActionTypeTerminate, nil, 15*time.Minute)
 	})
 }
 
@@ -196,29 +244,401 @@ func TestGlobalMetrics_Initialization(t *testing.T) {
 
 func TestActionMetrics_MultipleNodes(t *testing.T) {
 	// Test that metrics can track different nodes independently
-	m := &ActionMetrics{}
+	m := newTestMetrics(t)
 
 	nodes := []string{"node-1", "node-2", "node-3"}
 
 	for _, node := range nodes {
 		assert.NotPanics(t, func() {
-			m.IncActionCount(ActionTypeReboot, StatusStarted, node)
-			m.IncActionCount(ActionTypeReboot, StatusSucceeded, node)
+			m.IncActionCount(ActionTypeReboot, StatusStarted, node, nil)
+			m.IncActionCount(ActionTypeReboot, StatusSucceeded, node, nil)
 		})
 	}
 }
 
 func TestActionMetrics_DifferentActionTypes(t *testing.T) {
 	// Test that different action types can be tracked independently
-	m := &ActionMetrics{}
+	m := newTestMetrics(t)
 
 	assert.NotPanics(t, func() {
 		// Reboot actions
-		m.IncActionCount(ActionTypeReboot, StatusStarted, "node-1")
-		m.RecordActionMTTR(ActionTypeReboot, 1*time.Minute)
+		m.IncActionCount(ActionTypeReboot, StatusStarted, "node-1", nil)
+		m.RecordActionMTTR(ActionTypeReboot, nil, 1*time.Minute)
 
 		// Terminate actions
-		m.IncActionCount(ActionTypeTerminate, StatusStarted, "node-2")
-		m.RecordActionMTTR(ActionTypeTerminate, 2*time.Minute)
+		m.IncActionCount(ActionTypeTerminate, StatusStarted, "node-2", nil)
+		m.RecordActionMTTR(ActionTypeTerminate, nil, 2*time.Minute)
+	})
+}
+
+func TestActionMetrics_RecordReconcileDuration(t *testing.T) {
+	m := newTestMetrics(t)
+
+	for _, result := range []string{ReconcileResultSuccess, ReconcileResultRequeue, ReconcileResultError} {
+		assert.NotPanics(t, func() {
+			m.RecordReconcileDuration(ActionTypeReboot, result, 50*time.Millisecond)
+		})
+	}
+}
+
+func TestActionMetrics_SetRebootNodePhaseCount(t *testing.T) {
+	m := newTestMetrics(t)
+
+	for _, phase := range []string{PhasePending, PhaseInProgress, PhaseCompleted} {
+		assert.NotPanics(t, func() {
+			m.SetRebootNodePhaseCount(phase, 3)
+		})
+	}
+}
+
+func TestActionMetrics_IncReconcileOutcome(t *testing.T) {
+	m := newTestMetrics(t)
+
+	m.IncReconcileOutcome(ReconcileOutcomeSignalSent)
+	m.IncReconcileOutcome(ReconcileOutcomeMonitoring)
+	m.IncReconcileOutcome(ReconcileOutcomeMonitoring)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.reconcileOutcomes.WithLabelValues(ReconcileOutcomeSignalSent)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.reconcileOutcomes.WithLabelValues(ReconcileOutcomeMonitoring)))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.reconcileOutcomes))
+}
+
+func TestActionMetrics_RecordRebootPhaseDuration(t *testing.T) {
+	// The phases share the MTTR buckets
+	m, err := NewActionMetricsWithRegisterer(prometheus.NewRegistry(), WithMTTRBuckets(60, 600))
+	require.NoError(t, err)
+
+	m.RecordRebootPhaseDuration(RebootPhaseQueued, 30*time.Second)
+	m.RecordRebootPhaseDuration(RebootPhaseRebooting, 5*time.Minute)
+	m.RecordRebootPhaseDuration(RebootPhaseRebooting, 7*time.Minute)
+
+	expected := `
+# HELP janitor_reboot_phase_duration_seconds Time taken by each phase of a completed reboot
+# TYPE janitor_reboot_phase_duration_seconds histogram
+janitor_reboot_phase_duration_seconds_bucket{phase="queued",le="60"} 1
+janitor_reboot_phase_duration_seconds_bucket{phase="queued",le="600"} 1
+janitor_reboot_phase_duration_seconds_bucket{phase="queued",le="+Inf"} 1
+janitor_reboot_phase_duration_seconds_sum{phase="queued"} 30
+janitor_reboot_phase_duration_seconds_count{phase="queued"} 1
+janitor_reboot_phase_duration_seconds_bucket{phase="rebooting",le="60"} 0
+janitor_reboot_phase_duration_seconds_bucket{phase="rebooting",le="600"} 2
+janitor_reboot_phase_duration_seconds_bucket{phase="rebooting",le="+Inf"} 2
+janitor_reboot_phase_duration_seconds_sum{phase="rebooting"} 720
+janitor_reboot_phase_duration_seconds_count{phase="rebooting"} 2
+`
+	assert.NoError(t, testutil.CollectAndCompare(m.rebootPhaseDuration, strings.NewReader(expected)))
+}
+
+func TestActionMetrics_IncRebootSLABreach(t *testing.T) {
+	m := newTestMetrics(t)
+
+	assert.NotPanics(t, func() {
+		m.IncRebootSLABreach()
+	})
+}
+
+func TestActionMetrics_IncRebootAbandoned(t *testing.T) {
+	m := newTestMetrics(t)
+	before := testutil.ToFloat64(m.rebootsAbandoned.WithLabelValues())
+
+	m.IncRebootAbandoned()
+
+	assert.Equal(t, before+1, testutil.ToFloat64(m.rebootsAbandoned.WithLabelValues()))
+}
+
+func TestActionMetrics_IncRebootRetriesExhausted(t *testing.T) {
+	m := newTestMetrics(t)
+
+	m.IncRebootRetriesExhausted()
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.rebootRetriesExhausted.WithLabelValues()))
+}
+
+func TestActionMetrics_Reset(t *testing.T) {
+	m := newTestMetrics(t)
+
+	m.IncActionCount(ActionTypeReboot, StatusStarted, "node-1", nil)
+	m.IncActionCount(ActionTypeReboot, StatusStarted, "node-1", nil)
+	m.RecordActionMTTR(ActionTypeReboot, nil, time.Minute)
+	m.RecordReconcileDuration(ActionTypeReboot, ReconcileResultSuccess, time.Second)
+	m.SetRebootNodePhaseCount(PhaseInProgress, 3)
+	m.IncReconcileOutcome(ReconcileOutcomeNoop)
+	m.RecordRebootPhaseDuration(RebootPhaseQueued, time.Minute)
+	m.IncRebootSLABreach()
+	m.IncRebootAbandoned()
+	m.IncRebootRetriesExhausted()
+
+	m.Reset()
+
+	assert.Equal(t, 0, testutil.CollectAndCount(m.actionsCount))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.actionMTTRHistogram))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.reconcileDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.rebootNodesByPhase))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.reconcileOutcomes))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.rebootPhaseDuration))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.rebootSLABreaches.WithLabelValues()))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.rebootsAbandoned.WithLabelValues()))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.rebootRetriesExhausted.WithLabelValues()))
+
+	m.IncActionCount(ActionTypeReboot, StatusStarted, "node-1", nil)
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-1", nil)
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-1", nil)
+	m.IncRebootSLABreach()
+	m.IncRebootAbandoned()
+	m.IncRebootAbandoned()
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusStarted, "node-1", "")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusSucceeded, "node-1", "")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.rebootSLABreaches.WithLabelValues()))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.rebootsAbandoned.WithLabelValues()))
+}
+
+func TestActionMetrics_UnlabeledCountersReportedAtZero(t *testing.T) {
+	m := newTestMetrics(t)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rebootSLABreaches))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rebootsAbandoned))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rebootRetriesExhausted))
+
+	m.IncRebootAbandoned()
+	m.Reset()
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rebootsAbandoned))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.rebootsAbandoned.WithLabelValues()))
+}
+
+func TestGlobalMetrics_Reset(t *testing.T) {
+	GlobalMetrics.Reset()
+	t.Cleanup(GlobalMetrics.Reset)
+
+	IncActionCount(ActionTypeTerminate, StatusFailed, "global-reset-node", nil)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(GlobalMetrics.actionsCount))
+	assert.Equal(t, float64(1),
+		testutil.ToFloat64(GlobalMetrics.actionsCount.WithLabelValues(ActionTypeTerminate, StatusFailed, "global-reset-node", "")))
+}
+
+// gatherMTTRBuckets returns the cumulative count of every action MTTR histogram bucket by upper bound
+func gatherMTTRBuckets(t *testing.T, registry *prometheus.Registry) map[float64]uint64 {
+	t.Helper()
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	buckets := map[float64]uint64{}
+
+	for _, family := range families {
+		if family.GetName() != "janitor_action_mttr_seconds" {
+			continue
+		}
+
+		for _, bucket := range family.GetMetric()[0].GetHistogram().GetBucket() {
+			buckets[bucket.GetUpperBound()] = bucket.GetCumulativeCount()
+		}
+	}
+
+	return buckets
+}
+
+func TestNewActionMetricsWithRegisterer_MTTRBuckets(t *testing.T) {
+	t.Run("custom buckets", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+
+		m, err := NewActionMetricsWithRegisterer(registry, WithMTTRBuckets(60, 300, 900))
+		require.NoError(t, err)
+
+		m.RecordActionMTTR(ActionTypeReboot, nil, 4*time.Minute)
+
+		assert.Equal(t, map[float64]uint64{60: 0, 300: 1, 900: 1}, gatherMTTRBuckets(t, registry))
 	})
+
+	t.Run("default buckets", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+
+		m, err := NewActionMetricsWithRegisterer(registry)
+		require.NoError(t, err)
+
+		m.RecordActionMTTR(ActionTypeReboot, nil, 4*time.Minute)
+
+		buckets := gatherMTTRBuckets(t, registry)
+		assert.Len(t, buckets, len(DefaultMTTRBuckets))
+		assert.Equal(t, uint64(0), buckets[160])
+		assert.Equal(t, uint64(1), buckets[320])
+	})
+
+	t.Run("invalid buckets", func(t *testing.T) {
+		_, err := NewActionMetricsWithRegisterer(prometheus.NewRegistry(), WithMTTRBuckets(300, 60))
+		assert.Error(t, err)
+
+		_, err = NewActionMetricsWithRegisterer(prometheus.NewRegistry(), WithMTTRBuckets())
+		assert.Error(t, err)
+	})
+}
+
+func TestActionMetrics_NodeLabelMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		expectedNodes map[string]string
+	}{
+		{
+			name: "per node",
+			mode: NodeLabelPerNode,
+			expectedNodes: map[string]string{
+				StatusStarted:   "node-1",
+				StatusSucceeded: "node-1",
+				StatusFailed:    "node-1",
+			},
+		},
+		{
+			name: "none",
+			mode: NodeLabelNone,
+			expectedNodes: map[string]string{
+				StatusStarted:   "",
+				StatusSucceeded: "",
+				StatusFailed:    "",
+			},
+		},
+		{
+			name: "unsucceeded only",
+			mode: NodeLabelUnsucceededOnly,
+			expectedNodes: map[string]string{
+				StatusStarted:   "node-1",
+				StatusSucceeded: "",
+				StatusFailed:    "node-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := prometheus.NewRegistry()
+
+			m, err := NewActionMetricsWithRegisterer(registry, WithNodeLabelMode(tt.mode))
+			require.NoError(t, err)
+
+			for status := range tt.expectedNodes {
+				m.IncActionCount(ActionTypeReboot, status, "node-1", nil)
+			}
+
+			families, err := registry.Gather()
+			require.NoError(t, err)
+
+			nodes := map[string]string{}
+
+			for _, family := range families {
+				if family.GetName() != "janitor_actions_count" {
+					continue
+				}
+
+				for _, metric := range family.GetMetric() {
+					labels := map[string]string{}
+					for _, label := range metric.GetLabel() {
+						labels[label.GetName()] = label.GetValue()
+					}
+
+					nodes[labels["status"]] = labels["node"]
+				}
+			}
+
+			assert.Equal(t, tt.expectedNodes, nodes)
+		})
+	}
+}
+
+func TestActionMetrics_SetNodeLabelMode(t *testing.T) {
+	m := newTestMetrics(t)
+
+	require.NoError(t, m.SetNodeLabelMode(NodeLabelNone))
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-1", nil)
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-2", nil)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.actionsCount))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusSucceeded, "", "")))
+
+	assert.Error(t, m.SetNodeLabelMode("Hashed"))
+
+	_, err := NewActionMetricsWithRegisterer(prometheus.NewRegistry(), WithNodeLabelMode("Hashed"))
+	assert.Error(t, err)
+}
+
+func TestActionMetrics_NodePoolLabel(t *testing.T) {
+	const poolLabel = "example.com/node-pool"
+
+	m, err := NewActionMetricsWithRegisterer(prometheus.NewRegistry(), WithNodePoolLabel(poolLabel))
+	require.NoError(t, err)
+
+	pooled := map[string]string{poolLabel: "h100-a", "kubernetes.io/hostname": "node-1"}
+
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-1", pooled)
+	m.RecordActionMTTR(ActionTypeReboot, pooled, 4*time.Minute)
+
+	// Nodes without the pool label are counted without a pool
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-2", map[string]string{"kubernetes.io/hostname": "node-2"})
+	m.RecordActionMTTR(ActionTypeReboot, nil, 2*time.Minute)
+
+	assert.Equal(t, float64(1),
+		testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusSucceeded, "node-1", "h100-a")))
+	assert.Equal(t, float64(1),
+		testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusSucceeded, "node-2", "")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.actionMTTRHistogram))
+
+	// Other node labels are never copied, and clearing the pool label drops the pool
+	m.SetNodePoolLabel("")
+	m.IncActionCount(ActionTypeReboot, StatusSucceeded, "node-1", pooled)
+
+	assert.Equal(t, float64(1),
+		testutil.ToFloat64(m.actionsCount.WithLabelValues(ActionTypeReboot, StatusSucceeded, "node-1", "")))
+}
+
+func TestActionMetrics_RecordDrainDuration(t *testing.T) {
+	m := newTestMetrics(t)
+
+	m.RecordDrainDuration(DrainOutcomeCompleted, 45*time.Second)
+	m.RecordDrainDuration(DrainOutcomeCompleted, 2*time.Minute)
+	m.RecordDrainDuration(DrainOutcomeTimeout, 25*time.Minute)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(m.drainDuration))
+
+	expected := `
+# HELP janitor_drain_duration_seconds Time taken to cordon a node and evict its pods before terminating it
+# TYPE janitor_drain_duration_seconds histogram
+`
+	var lines strings.Builder
+
+	lines.WriteString(expected)
+
+	for _, outcome := range []struct {
+		name         string
+		observations []float64
+	}{
+		{DrainOutcomeCompleted, []float64{45, 120}},
+		{DrainOutcomeTimeout, []float64{1500}},
+	} {
+		sum := 0.0
+
+		for _, bound := range prometheus.ExponentialBuckets(1, 2, 12) {
+			count := 0
+
+			for _, observation := range outcome.observations {
+				if observation <= bound {
+					count++
+				}
+			}
+
+			fmt.Fprintf(&lines, "janitor_drain_duration_seconds_bucket{outcome=%q,le=\"%g\"} %d\n", outcome.name, bound, count)
+		}
+
+		for _, observation := range outcome.observations {
+			sum += observation
+		}
+
+		fmt.Fprintf(&lines, "janitor_drain_duration_seconds_bucket{outcome=%q,le=\"+Inf\"} %d\n",
+			outcome.name, len(outcome.observations))
+		fmt.Fprintf(&lines, "janitor_drain_duration_seconds_sum{outcome=%q} %g\n", outcome.name, sum)
+		fmt.Fprintf(&lines, "janitor_drain_duration_seconds_count{outcome=%q} %d\n",
+			outcome.name, len(outcome.observations))
+	}
+
+	assert.NoError(t, testutil.CollectAndCompare(m.drainDuration, strings.NewReader(lines.String())))
 }
@@ -26,10 +26,37 @@ type ResetSignalRequestRef string
 // TerminateNodeRequestRef represents a reference to a terminate node request
 type TerminateNodeRequestRef string
 
+// RebootType selects how forcefully the CSP restarts a node
+type RebootType string
+
+const (
+	// RebootTypeSoft requests a graceful restart that lets the guest OS shut down
+	RebootTypeSoft RebootType = "Soft"
+	// RebootTypeHard requests a power cycle of the instance
+	RebootTypeHard RebootType = "Hard"
+)
+
+// RebootOptions carries the parameters of a reboot request
+type RebootOptions struct {
+	// RebootType selects a soft or hard reboot; providers with a single reboot action ignore it
+	RebootType RebootType
+	// Tags describe the request, e.g. the RebootNode that issued it, for providers that can record them
+	Tags map[string]string
+}
+
+// IsHard returns true if a hard reboot was requested
+func (o RebootOptions) IsHard() bool {
+	return o.RebootType == RebootTypeHard
+}
+
 // CSPClient defines the interface for cloud service provider operations
 type CSPClient interface {
 	// SendRebootSignal sends a reboot signal to the node via the CSP
-	SendRebootSignal(ctx context.Context, node corev1.Node) (ResetSignalRequestRef, error)
+	SendRebootSignal(ctx context.Context, node corev1.Node, opts RebootOptions) (ResetSignalRequestRef, error)
+
+	// IsRebootComplete checks if the CSP operation started by SendRebootSignal has finished.
+	// Providers that do not return an operation handle report true.
+	IsRebootComplete(ctx context.Context, node corev1.Node, reqRef string) (bool, error)
 
 	// IsNodeReady checks if the node is ready after a reboot operation
 	IsNodeReady(ctx context.Context, node corev1.Node, message string) (bool, error)
@@ -37,3 +64,48 @@ type CSPClient interface {
 	// SendTerminateSignal sends a termination signal to the node via the CSP
 	SendTerminateSignal(ctx context.Context, node corev1.Node) (TerminateNodeRequestRef, error)
 }
+
+// PendingOperationChecker is implemented by CSP clients that can tell whether an operation they
+// started on a node, e.g. a reboot, has not finished yet
+type PendingOperationChecker interface {
+	// HasPendingOperation returns true if a CSP operation on the node is still pending
+	HasPendingOperation(ctx context.Context, node corev1.Node) (bool, error)
+}
+
+// OperationCanceller is implemented by CSP clients that can cancel a pending operation on a node
+type OperationCanceller interface {
+	// CancelPendingOperation cancels the CSP operation still pending on the node
+	CancelPendingOperation(ctx context.Context, node corev1.Node) error
+}
+
+// AsPendingOperationChecker returns the client as a PendingOperationChecker, looking through wrappers
+// such as the retrying client that expose the client they wrap with Unwrap
+func AsPendingOperationChecker(client CSPClient) (PendingOperationChecker, bool) {
+	return asOptional[PendingOperationChecker](client)
+}
+
+// AsOperationCanceller returns the client as an OperationCanceller, looking through wrappers such as
+// the retrying client that expose the client they wrap with Unwrap
+func AsOperationCanceller(client CSPClient) (OperationCanceller, bool) {
+	return asOptional[OperationCanceller](client)
+}
+
+// asOptional returns the first client in the chain of wrapped clients that implements T
+func asOptional[T any](client CSPClient) (T, bool) {
+	for client != nil {
+		if optional, ok := client.(T); ok {
+			return optional, true
+		}
+
+		wrapper, ok := client.(interface{ Unwrap() CSPClient })
+		if !ok {
+			break
+		}
+
+		client = wrapper.Unwrap()
+	}
+
+	var zero T
+
+	return zero, false
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+)
+
+// Classifications of CSP errors. A CSPError matches the classification of its cause with errors.Is.
+// Providers can classify errors the generic rules miss by wrapping them with one of these.
+var (
+	// ErrCSPTransient marks a failure that is expected to succeed when retried
+	ErrCSPTransient = errors.New("transient CSP error")
+	// ErrCSPRateLimited marks a request rejected by the CSP rate limits
+	ErrCSPRateLimited = errors.New("CSP rate limit exceeded")
+	// ErrCSPNotFound marks a request for an instance or operation the CSP does not know
+	ErrCSPNotFound = errors.New("CSP resource not found")
+	// ErrCSPRequestExpired marks a request reference returned by SendRebootSignal that the CSP no
+	// longer knows, e.g. an operation that was garbage collected during a long reboot
+	ErrCSPRequestExpired = errors.New("CSP request reference expired")
+)
+
+// CSPError carries the context of a failed CSPClient call
+type CSPError struct {
+	// Provider is the CSP the call was made to, if known
+	Provider string
+	// Operation is the CSPClient method that failed, e.g. SendRebootSignal
+	Operation string
+	// Node is the name of the node the call was made for
+	Node string
+	// ReqRef is the reference of the CSP request the call refers to, if any
+	ReqRef string
+	// Err is the error returned by the provider
+	Err error
+
+	classification error
+}
+
+// NewCSPError wraps err with the context of the CSP call that returned it, classifying it as
+// transient, rate limited or not found where possible. It returns nil if err is nil.
+func NewCSPError(provider, operation, node, reqRef string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &CSPError{
+		Provider:       provider,
+		Operation:      operation,
+		Node:           node,
+		ReqRef:         reqRef,
+		Err:            err,
+		classification: classifyCSPError(err),
+	}
+}
+
+// Error returns the provider error prefixed with the call context
+func (e *CSPError) Error() string {
+	var b strings.Builder
+
+	if e.Provider != "" {
+		b.WriteString(e.Provider)
+		b.WriteString(" ")
+	}
+
+	fmt.Fprintf(&b, "%s failed for node %s", e.Operation, e.Node)
+
+	if e.ReqRef != "" {
+		fmt.Fprintf(&b, " (request %s)", e.ReqRef)
+	}
+
+	fmt.Fprintf(&b, ": %v", e.Err)
+
+	return b.String()
+}
+
+// Unwrap returns the provider error and, if it was classified, its classification
+func (e *CSPError) Unwrap() []error {
+	if e.classification == nil {
+		return []error{e.Err}
+	}
+
+	return []error{e.Err, e.classification}
+}
+
+// IsTransientCSPError reports whether err, classified or not, is a CSP failure that is expected to
+// succeed when retried right away. Rate limited requests are not, as retrying them adds to the load.
+func IsTransientCSPError(err error) bool {
+	return errors.Is(err, ErrCSPTransient) || errors.Is(classifyCSPError(err), ErrCSPTransient)
+}
+
+// classifyCSPError derives a classification from the provider error. Errors that are already
+// classified keep their classification, and unknown errors are left unclassified.
+func classifyCSPError(err error) error {
+	if errors.Is(err, ErrCSPTransient) || errors.Is(err, ErrCSPRateLimited) || errors.Is(err, ErrCSPNotFound) ||
+		errors.Is(err, ErrCSPRequestExpired) {
+		return nil
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrCSPTransient
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrCSPTransient
+	}
+
+	// A connection dropped mid-request, e.g. by a load balancer, succeeds when retried
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrCSPTransient
+	}
+
+	switch statusCode := httpStatusCode(err); {
+	case statusCode == http.StatusTooManyRequests:
+		return ErrCSPRateLimited
+	case statusCode == http.StatusNotFound:
+		return ErrCSPNotFound
+	case statusCode >= http.StatusInternalServerError:
+		return ErrCSPTransient
+	}
+
+	return nil
+}
+
+// httpStatusCode returns the HTTP status code of a provider SDK error, or 0 if it carries none.
+// The AWS SDK exposes it as HTTPStatusCode and the OCI SDK as GetHTTPStatusCode.
+func httpStatusCode(err error) int {
+	var awsErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &awsErr) {
+		return awsErr.HTTPStatusCode()
+	}
+
+	var ociErr interface{ GetHTTPStatusCode() int }
+	if errors.As(err, &ociErr) {
+		return ociErr.GetHTTPStatusCode()
+	}
+
+	return 0
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusCodeError mimics an AWS SDK response error
+type statusCodeError struct {
+	code int
+}
+
+func (e *statusCodeError) Error() string       { return fmt.Sprintf("status %d", e.code) }
+func (e *statusCodeError) HTTPStatusCode() int { return e.code }
+
+// ociStatusCodeError mimics an OCI SDK service error
+type ociStatusCodeError struct {
+	code int
+}
+
+func (e *ociStatusCodeError) Error() string          { return fmt.Sprintf("status %d", e.code) }
+func (e *ociStatusCodeError) GetHTTPStatusCode() int { return e.code }
+
+func TestNewCSPError_Nil(t *testing.T) {
+	assert.NoError(t, NewCSPError("aws", "SendRebootSignal", "node-1", "", nil))
+}
+
+func TestNewCSPError_Fields(t *testing.T) {
+	cause := errors.New("instance busy")
+
+	err := NewCSPError("gcp", "IsNodeReady", "node-1", "operation-123", cause)
+	require.Error(t, err)
+
+	var cspErr *CSPError
+	require.ErrorAs(t, err, &cspErr)
+	assert.Equal(t, "gcp", cspErr.Provider)
+	assert.Equal(t, "IsNodeReady", cspErr.Operation)
+	assert.Equal(t, "node-1", cspErr.Node)
+	assert.Equal(t, "operation-123", cspErr.ReqRef)
+	assert.Equal(t, cause, cspErr.Err)
+
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "gcp IsNodeReady failed for node node-1 (request operation-123): instance busy", err.Error())
+}
+
+func TestNewCSPError_MessageWithoutOptionalFields(t *testing.T) {
+	err := NewCSPError("", "SendTerminateSignal", "node-1", "", errors.New("denied"))
+	assert.Equal(t, "SendTerminateSignal failed for node node-1: denied", err.Error())
+}
+
+func TestNewCSPError_Classification(t *testing.T) {
+	tests := []struct {
+		name     string
+		cause    error
+		expected error
+	}{
+		{name: "deadline exceeded", cause: context.DeadlineExceeded, expected: ErrCSPTransient},
+		{name: "rate limited", cause: &statusCodeError{code: 429}, expected: ErrCSPRateLimited},
+		{name: "not found", cause: &statusCodeError{code: 404}, expected: ErrCSPNotFound},
+		{name: "server error", cause: &statusCodeError{code: 503}, expected: ErrCSPTransient},
+		{name: "connection reset", cause: fmt.Errorf("read tcp: %w", syscall.ECONNRESET), expected: ErrCSPTransient},
+		{name: "unexpected eof", cause: io.ErrUnexpectedEOF, expected: ErrCSPTransient},
+		{name: "oci rate limited", cause: &ociStatusCodeError{code: 429}, expected: ErrCSPRateLimited},
+		{name: "wrapped status code", cause: fmt.Errorf("reboot: %w", &statusCodeError{code: 404}), expected: ErrCSPNotFound},
+		{name: "classified by provider", cause: fmt.Errorf("%w: quota", ErrCSPRateLimited), expected: ErrCSPRateLimited},
+		{name: "expired request reference", cause: fmt.Errorf("%w: operation-1: %w", ErrCSPRequestExpired, &statusCodeError{code: 404}), expected: nil},
+		{name: "unclassified", cause: &statusCodeError{code: 400}, expected: nil},
+	}
+
+	classifications := []error{ErrCSPTransient, ErrCSPRateLimited, ErrCSPNotFound}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewCSPError("aws", "SendRebootSignal", "node-1", "", tt.cause)
+
+			assert.ErrorIs(t, err, tt.cause)
+
+			for _, classification := range classifications {
+				assert.Equal(t, classification == tt.expected, errors.Is(err, classification),
+					"classification %v", classification)
+			}
+
+			var statusErr *statusCodeError
+			if errors.As(tt.cause, &statusErr) {
+				var unwrapped *statusCodeError
+				require.ErrorAs(t, err, &unwrapped)
+				assert.Equal(t, statusErr.code, unwrapped.code)
+			}
+		})
+	}
+}
+
+func TestIsTransientCSPError(t *testing.T) {
+	assert.True(t, IsTransientCSPError(syscall.ECONNRESET))
+	assert.True(t, IsTransientCSPError(NewCSPError("aws", "IsNodeReady", "node-1", "", &statusCodeError{code: 503})))
+	assert.True(t, IsTransientCSPError(fmt.Errorf("%w: backend busy", ErrCSPTransient)))
+	assert.False(t, IsTransientCSPError(&statusCodeError{code: 429}))
+	assert.False(t, IsTransientCSPError(&statusCodeError{code: 400}))
+	assert.False(t, IsTransientCSPError(nil))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+// rebootOutcomeVersion is the version of the RebootOutcome message sent by the gRPC sink
+const rebootOutcomeVersion = 1
+
+// GRPCSink sends each outcome to a RebootOutcomeReceiver service
+type GRPCSink struct {
+	conn    *grpc.ClientConn
+	client  pb.RebootOutcomeReceiverClient
+	timeout time.Duration
+}
+
+// NewGRPCSink returns a sink that sends outcomes to the receiver at target, bounding each call by timeout.
+// The connection is insecure unless opts provide transport credentials.
+func NewGRPCSink(target string, timeout time.Duration, opts ...grpc.DialOption) (*GRPCSink, error) {
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+
+	dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client for %s: %w", target, err)
+	}
+
+	return &GRPCSink{
+		conn:    conn,
+		client:  pb.NewRebootOutcomeReceiverClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// Notify sends the outcome to the receiver
+func (s *GRPCSink) Notify(ctx context.Context, outcome RebootOutcome) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	msg := &pb.RebootOutcome{
+		Version:        rebootOutcomeVersion,
+		RebootNode:     outcome.RebootNode,
+		Node:           outcome.Node,
+		Outcome:        outcome.Outcome,
+		Reason:         outcome.Reason,
+		Duration:       durationpb.New(time.Duration(outcome.DurationSeconds * float64(time.Second))),
+		Attempts:       outcome.Attempts,
+		CompletionTime: timestamppb.New(outcome.CompletionTime),
+	}
+
+	if _, err := s.client.RebootOutcomeOccurredV1(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send reboot outcome: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the connection to the receiver
+func (s *GRPCSink) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+type fakeReceiver struct {
+	pb.UnimplementedRebootOutcomeReceiverServer
+
+	received chan *pb.RebootOutcome
+	err      error
+}
+
+func (f *fakeReceiver) RebootOutcomeOccurredV1(ctx context.Context, msg *pb.RebootOutcome) (*emptypb.Empty, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.received <- msg
+
+	return &emptypb.Empty{}, nil
+}
+
+// newInProcessGRPCSink starts receiver on an in-memory listener and returns a sink connected to it
+func newInProcessGRPCSink(t *testing.T, receiver *fakeReceiver) *GRPCSink {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterRebootOutcomeReceiverServer(server, receiver)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	sink, err := NewGRPCSink("passthrough:///bufnet", time.Second,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = sink.Close() })
+
+	return sink
+}
+
+func TestGRPCSink_SendsOutcome(t *testing.T) {
+	receiver := &fakeReceiver{received: make(chan *pb.RebootOutcome, 1)}
+	sink := newInProcessGRPCSink(t, receiver)
+
+	completion := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	outcome := RebootOutcome{
+		RebootNode:      "reboot-node-1",
+		Node:            "node-1",
+		Outcome:         OutcomeTimeout,
+		Reason:          "Node failed to return to ready state after timeout duration",
+		DurationSeconds: 90,
+		Attempts:        3,
+		CompletionTime:  completion,
+	}
+
+	require.NoError(t, sink.Notify(context.Background(), outcome))
+
+	msg := <-receiver.received
+	assert.Equal(t, uint32(rebootOutcomeVersion), msg.GetVersion())
+	assert.Equal(t, "reboot-node-1", msg.GetRebootNode())
+	assert.Equal(t, "node-1", msg.GetNode())
+	assert.Equal(t, OutcomeTimeout, msg.GetOutcome())
+	assert.Equal(t, outcome.Reason, msg.GetReason())
+	assert.Equal(t, 90*time.Second, msg.GetDuration().AsDuration())
+	assert.Equal(t, int32(3), msg.GetAttempts())
+	assert.True(t, completion.Equal(msg.GetCompletionTime().AsTime()))
+}
+
+func TestGRPCSink_ReceiverError(t *testing.T) {
+	receiver := &fakeReceiver{err: status.Error(codes.Unavailable, "receiver down")}
+	sink := newInProcessGRPCSink(t, receiver)
+
+	err := sink.Notify(context.Background(), RebootOutcome{Node: "node-1"})
+	assert.ErrorContains(t, err, "receiver down")
+}
+
+func TestQueuedSink_DeliversOverGRPC(t *testing.T) {
+	receiver := &fakeReceiver{received: make(chan *pb.RebootOutcome, 1)}
+	q := NewQueuedSink(newInProcessGRPCSink(t, receiver), 10, 1)
+
+	startQueue(t, q)
+
+	require.NoError(t, q.Notify(context.Background(), RebootOutcome{Node: "node-1", Outcome: OutcomeSucceeded}))
+
+	select {
+	case msg := <-receiver.received:
+		assert.Equal(t, "node-1", msg.GetNode())
+		assert.Equal(t, OutcomeSucceeded, msg.GetOutcome())
+	case <-time.After(5 * time.Second):
+		t.Fatal("receiver did not get the reboot outcome")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notification delivers janitor action outcomes to external systems such as incident tooling.
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+// Outcome values describe how a RebootNode reached its terminal state
+const (
+	OutcomeSucceeded          = "Succeeded"
+	OutcomeFailed             = "Failed"
+	OutcomeTimeout            = "Timeout"
+	OutcomeMaxRetriesExceeded = "MaxRetriesExceeded"
+	OutcomeExcluded           = "Excluded"
+)
+
+// RebootOutcome summarizes a RebootNode that reached a terminal state
+type RebootOutcome struct {
+	RebootNode      string    `json:"rebootNode"`
+	Node            string    `json:"node"`
+	Outcome         string    `json:"outcome"`
+	Reason          string    `json:"reason"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Attempts        int32     `json:"attempts"`
+	CompletionTime  time.Time `json:"completionTime"`
+}
+
+// NotificationSink receives the outcome of every RebootNode that reaches a terminal state.
+// Implementations deliver synchronously; wrap them in a QueuedSink to keep delivery off the reconcile path.
+type NotificationSink interface {
+	Notify(ctx context.Context, outcome RebootOutcome) error
+}
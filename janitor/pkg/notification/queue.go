@@ -0,0 +1,121 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultQueueSize is the number of outcomes buffered for delivery when no size is configured
+	DefaultQueueSize = 100
+
+	// DefaultMaxRetries is the number of redeliveries attempted when no limit is configured
+	DefaultMaxRetries = 3
+
+	// retryBaseDelay is the delay before the first redelivery; it doubles with every attempt
+	retryBaseDelay = time.Second
+)
+
+// QueuedSink buffers outcomes in a bounded queue and delivers them to another sink in the background,
+// so a slow or unavailable endpoint never blocks a reconcile. Outcomes are dropped when the queue is full.
+type QueuedSink struct {
+	sink       NotificationSink
+	queue      chan RebootOutcome
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// NewQueuedSink wraps sink with a queue of queueSize outcomes, each redelivered up to maxRetries times
+func NewQueuedSink(sink NotificationSink, queueSize, maxRetries int) *QueuedSink {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxRetries
+	}
+
+	return &QueuedSink{
+		sink:       sink,
+		queue:      make(chan RebootOutcome, queueSize),
+		maxRetries: maxRetries,
+		retryDelay: retryBaseDelay,
+	}
+}
+
+// Notify enqueues the outcome without blocking
+func (q *QueuedSink) Notify(ctx context.Context, outcome RebootOutcome) error {
+	select {
+	case q.queue <- outcome:
+	default:
+		log.FromContext(ctx).Info("notification queue is full, dropping reboot outcome",
+			"node", outcome.Node,
+			"rebootNode", outcome.RebootNode,
+			"outcome", outcome.Outcome)
+	}
+
+	return nil
+}
+
+// Start delivers queued outcomes until the context is cancelled, then closes the underlying sink
+// if it holds a connection. It implements manager.Runnable.
+func (q *QueuedSink) Start(ctx context.Context) error {
+	if closer, ok := q.sink.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case outcome := <-q.queue:
+			q.deliver(ctx, outcome)
+		}
+	}
+}
+
+// deliver sends the outcome, retrying with exponential backoff until it succeeds or retries are exhausted
+func (q *QueuedSink) deliver(ctx context.Context, outcome RebootOutcome) {
+	delay := q.retryDelay
+
+	for attempt := 0; ; attempt++ {
+		err := q.sink.Notify(ctx, outcome)
+		if err == nil {
+			return
+		}
+
+		if attempt >= q.maxRetries {
+			log.FromContext(ctx).Error(err, "failed to deliver reboot outcome",
+				"node", outcome.Node,
+				"rebootNode", outcome.RebootNode,
+				"attempts", attempt+1)
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSink fails the first failures deliveries and records every successful one
+type fakeSink struct {
+	mu        sync.Mutex
+	failures  int
+	calls     int
+	delivered []RebootOutcome
+}
+
+func (f *fakeSink) Notify(ctx context.Context, outcome RebootOutcome) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	if f.calls <= f.failures {
+		return errors.New("unavailable")
+	}
+
+	f.delivered = append(f.delivered, outcome)
+
+	return nil
+}
+
+func (f *fakeSink) snapshot() (int, []RebootOutcome) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls, append([]RebootOutcome(nil), f.delivered...)
+}
+
+func startQueue(t *testing.T, q *QueuedSink) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		assert.NoError(t, q.Start(ctx))
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func TestQueuedSink_RetriesUntilDelivered(t *testing.T) {
+	sink := &fakeSink{failures: 2}
+	q := NewQueuedSink(sink, 10, 3)
+	q.retryDelay = time.Millisecond
+
+	startQueue(t, q)
+
+	assert.NoError(t, q.Notify(context.Background(), RebootOutcome{Node: "node-1"}))
+
+	assert.Eventually(t, func() bool {
+		_, delivered := sink.snapshot()
+		return len(delivered) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	calls, _ := sink.snapshot()
+	assert.Equal(t, 3, calls)
+}
+
+func TestQueuedSink_GivesUpAfterMaxRetries(t *testing.T) {
+	sink := &fakeSink{failures: 10}
+	q := NewQueuedSink(sink, 10, 2)
+	q.retryDelay = time.Millisecond
+
+	startQueue(t, q)
+
+	assert.NoError(t, q.Notify(context.Background(), RebootOutcome{Node: "node-1"}))
+	assert.NoError(t, q.Notify(context.Background(), RebootOutcome{Node: "node-2"}))
+
+	// Each outcome is attempted once plus two retries
+	assert.Eventually(t, func() bool {
+		calls, _ := sink.snapshot()
+		return calls == 6
+	}, time.Second, 5*time.Millisecond)
+
+	_, delivered := sink.snapshot()
+	assert.Empty(t, delivered)
+}
+
+func TestQueuedSink_DropsWhenFull(t *testing.T) {
+	sink := &fakeSink{}
+	q := NewQueuedSink(sink, 2, 1)
+
+	// Without a running worker the queue fills up, and Notify must not block
+	for i := range 5 {
+		assert.NoError(t, q.Notify(context.Background(), RebootOutcome{Attempts: int32(i)}))
+	}
+
+	assert.Len(t, q.queue, 2)
+
+	startQueue(t, q)
+
+	assert.Eventually(t, func() bool {
+		_, delivered := sink.snapshot()
+		return len(delivered) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	_, delivered := sink.snapshot()
+	assert.Equal(t, int32(0), delivered[0].Attempts)
+	assert.Equal(t, int32(1), delivered[1].Attempts)
+}
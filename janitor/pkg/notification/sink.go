@@ -0,0 +1,44 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"errors"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+// NewSinkFromConfig returns a queued sink for the endpoint selected in cfg, or nil when notifications are disabled
+func NewSinkFromConfig(cfg config.NotificationConfig) (*QueuedSink, error) {
+	var sink NotificationSink
+
+	switch {
+	case cfg.WebhookURL != "" && cfg.GRPCTarget != "":
+		return nil, errors.New("notification webhookURL and grpcTarget are mutually exclusive")
+	case cfg.WebhookURL != "":
+		sink = NewWebhookSink(cfg.WebhookURL, cfg.Timeout)
+	case cfg.GRPCTarget != "":
+		grpcSink, err := NewGRPCSink(cfg.GRPCTarget, cfg.Timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		sink = grpcSink
+	default:
+		return nil, nil
+	}
+
+	return NewQueuedSink(sink, cfg.QueueSize, cfg.MaxRetries), nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+func TestNewSinkFromConfig(t *testing.T) {
+	t.Run("disabled when no endpoint is set", func(t *testing.T) {
+		sink, err := NewSinkFromConfig(config.NotificationConfig{})
+		require.NoError(t, err)
+		assert.Nil(t, sink)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		sink, err := NewSinkFromConfig(config.NotificationConfig{WebhookURL: "http://localhost:8080"})
+		require.NoError(t, err)
+		require.NotNil(t, sink)
+		assert.IsType(t, &WebhookSink{}, sink.sink)
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		sink, err := NewSinkFromConfig(config.NotificationConfig{GRPCTarget: "localhost:50051", QueueSize: 5})
+		require.NoError(t, err)
+		require.NotNil(t, sink)
+		assert.IsType(t, &GRPCSink{}, sink.sink)
+		assert.Equal(t, 5, cap(sink.queue))
+		assert.NoError(t, sink.sink.(*GRPCSink).Close())
+	})
+
+	t.Run("both endpoints are rejected", func(t *testing.T) {
+		_, err := NewSinkFromConfig(config.NotificationConfig{
+			WebhookURL: "http://localhost:8080",
+			GRPCTarget: "localhost:50051",
+		})
+		assert.Error(t, err)
+	})
+}
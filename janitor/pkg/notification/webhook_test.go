@@ -0,0 +1,65 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_PostsOutcome(t *testing.T) {
+	received := make(chan RebootOutcome, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var outcome RebootOutcome
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&outcome))
+
+		received <- outcome
+	}))
+	defer server.Close()
+
+	outcome := RebootOutcome{
+		RebootNode:      "reboot-node-1",
+		Node:            "node-1",
+		Outcome:         OutcomeSucceeded,
+		Reason:          "Node reached ready state post-reboot",
+		DurationSeconds: 120,
+		Attempts:        4,
+		CompletionTime:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	require.NoError(t, NewWebhookSink(server.URL, time.Second).Notify(context.Background(), outcome))
+	assert.Equal(t, outcome, <-received)
+}
+
+func TestWebhookSink_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL, time.Second).Notify(context.Background(), RebootOutcome{Node: "node-1"})
+	assert.ErrorContains(t, err, "503")
+}
@@ -22,7 +22,9 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/logger"
 	"github.com/nvidia/nvsentinel/commons/pkg/server"
@@ -49,7 +51,8 @@ func main() {
 }
 
 func run() error {
-	kubeconfig, metricsPort, dcgmAppLabel, driverAppLabel, kataLabel := parseFlags()
+	kubeconfig, metricsPort, dcgmAppLabel, driverAppLabel, falseLabelMode, cacheSyncAttempts, cacheSyncTimeout,
+		kata, kataCR, maintenance, driverSource, runtimeFeatures, operatorGate, debugEndpoints := parseFlags()
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -59,17 +62,46 @@ func run() error {
 		return fmt.Errorf("invalid metrics port: %w", err)
 	}
 
-	srv := server.NewServer(
-		server.WithPort(portInt),
-		server.WithPrometheusMetrics(),
-		server.WithSimpleHealth(),
-	)
-
 	params := initializer.InitializationParams{
-		KubeconfigPath: *kubeconfig,
-		DCGMAppLabel:   *dcgmAppLabel,
-		DriverAppLabel: *driverAppLabel,
-		KataLabel:      *kataLabel,
+		KubeconfigPath:  *kubeconfig,
+		DCGMAppLabels:   splitAppLabels(*dcgmAppLabel),
+		DriverAppLabels: splitAppLabels(*driverAppLabel),
+		KataLabel:       *kata.label,
+
+		KataExtendedResource:          *kata.extendedResource,
+		KataRequireLabelCorroboration: *kata.requireLabelCorroboration,
+		KataRuntimeClassDetection:     *kata.runtimeClassDetection,
+		KataObserveOnly:               *kata.observeOnly,
+		KataDetectOnce:                *kata.detectOnce,
+		KataDefaultLabelValue:         *kata.defaultLabelValue,
+		DetectionFalseLabelMode:       *falseLabelMode,
+
+		CacheSyncAttempts: *cacheSyncAttempts,
+		CacheSyncTimeout:  *cacheSyncTimeout,
+
+		KataCRResource:  *kataCR.resource,
+		KataCRNamespace: *kataCR.namespace,
+		KataCRName:      *kataCR.name,
+		KataCRFieldPath: *kataCR.fieldPath,
+
+		MaxConcurrentKataDetections: *kataCR.maxConcurrentDetections,
+		KataDetectionTimeout:        *kataCR.detectionTimeout,
+		KataCRCacheTTL:              *kataCR.cacheTTL,
+		KataCRMissingCacheTTL:       *kataCR.missingCacheTTL,
+		KataResultOnTimeout:         *kataCR.resultOnTimeout,
+
+		MaintenanceAnnotation: *maintenance.annotation,
+		MaintenanceTaintKey:   *maintenance.taintKey,
+
+		DriverSource:        *driverSource.source,
+		DriverNodeCondition: *driverSource.condition,
+		DriverNodeLabel:     *driverSource.label,
+
+		RuntimeFeatures: *runtimeFeatures,
+
+		WaitForGPUOperator:     *operatorGate.enabled,
+		GPUOperatorNamespace:   *operatorGate.namespace,
+		GPUOperatorWaitTimeout: *operatorGate.timeout,
 	}
 
 	components, err := initializer.InitializeAll(params)
@@ -77,6 +109,19 @@ func run() error {
 		return fmt.Errorf("initialization failed: %w", err)
 	}
 
+	serverOpts := []server.Option{
+		server.WithPort(portInt),
+		server.WithPrometheusMetrics(),
+		server.WithSimpleHealth(),
+	}
+
+	if *debugEndpoints {
+		serverOpts = append(serverOpts,
+			server.WithHandler(labeler.CacheDumpPath, components.Labeler.CacheDumpHandler()))
+	}
+
+	srv := server.NewServer(serverOpts...)
+
 	g, gCtx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
@@ -96,16 +141,165 @@ func run() error {
 	return g.Wait()
 }
 
-func parseFlags() (kubeconfig, metricsPort, dcgmAppLabel, driverAppLabel, kataLabel *string) {
+// kataFlags configure how Kata Containers support is detected from the node and labeled
+type kataFlags struct {
+	label                     *string
+	extendedResource          *string
+	defaultLabelValue         *string
+	requireLabelCorroboration *bool
+	runtimeClassDetection     *bool
+	observeOnly               *bool
+	detectOnce                *bool
+}
+
+// kataCRFlags configure the optional Kata detection from a custom resource
+type kataCRFlags struct {
+	resource  *string
+	namespace *string
+	name      *string
+	fieldPath *string
+
+	maxConcurrentDetections *int
+	detectionTimeout        *time.Duration
+	cacheTTL                *time.Duration
+	missingCacheTTL         *time.Duration
+	resultOnTimeout         *bool
+}
+
+// maintenanceFlags configure the optional suppression of ready-implying labels during node maintenance
+type maintenanceFlags struct {
+	annotation *string
+	taintKey   *string
+}
+
+// driverSourceFlags configure where the driver installed label is derived from
+type driverSourceFlags struct {
+	source    *string
+	condition *string
+	label     *string
+}
+
+// operatorGateFlags configure the optional wait for the GPU operator DaemonSets before labeling
+type operatorGateFlags struct {
+	enabled   *bool
+	namespace *string
+	timeout   *time.Duration
+}
+
+func parseFlags() (kubeconfig, metricsPort, dcgmAppLabel, driverAppLabel, falseLabelMode *string,
+	cacheSyncAttempts *int, cacheSyncTimeout *time.Duration, kata kataFlags, kataCR kataCRFlags,
+	maintenance maintenanceFlags, driverSource driverSourceFlags, runtimeFeatures *[]string,
+	operatorGate operatorGateFlags, debugEndpoints *bool) {
 	kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 	metricsPort = flag.String("metrics-port", "2112", "Port to expose Prometheus metrics on")
-	dcgmAppLabel = flag.String("dcgm-app-label", "nvidia-dcgm", "App label value for DCGM pods")
-	driverAppLabel = flag.String("driver-app-label", "nvidia-driver-daemonset", "App label value for driver pods")
-	kataLabel = flag.String("kata-label", "",
+	debugEndpoints = flag.Bool("enable-debug-endpoints", false,
+		fmt.Sprintf("Serve a JSON dump of the detection caches at %s on the metrics port", labeler.CacheDumpPath))
+	dcgmAppLabel = flag.String("dcgm-app-label", "nvidia-dcgm",
+		"App label value for DCGM pods. Multiple values may be given as a comma-separated list")
+	driverAppLabel = flag.String("driver-app-label", "nvidia-driver-daemonset",
+		"App label value for driver pods. Multiple values may be given as a comma-separated list")
+	kata.label = flag.String("kata-label", "",
 		fmt.Sprintf("Custom node label to check for Kata Containers support. If empty, uses default '%s'",
 			labeler.KataRuntimeDefaultLabel))
+	kata.extendedResource = flag.String("kata-extended-resource", "",
+		"Node extended resource whose positive allocatable or capacity quantity detects Kata Containers "+
+			"(e.g. katacontainers.io/kata). Empty disables extended resource detection")
+	kata.requireLabelCorroboration = flag.Bool("kata-require-label-corroboration", false,
+		"Only trust a Kata node label when the extended resource, RuntimeClass or custom resource detection reports Kata "+
+			"as well, so that stale labels do not report Kata. Nodes without a Kata label are then never reported as Kata")
+	kata.runtimeClassDetection = flag.Bool("kata-runtimeclass-detection", false,
+		"Detect Kata Containers on the nodes selected by the node selector of a RuntimeClass with a kata handler. "+
+			"RuntimeClasses are watched, so adding or removing one re-detects the nodes it selects right away")
+	kata.observeOnly = flag.Bool("kata-observe-only", false,
+		fmt.Sprintf("Run Kata detection and record its metrics without writing the '%s' label, logging the value "+
+			"each node would get instead. The DCGM, driver and runtime labels are still written", labeler.KataEnabledLabel))
+	kata.detectOnce = flag.Bool("kata-detect-once", false,
+		"Keep the Kata custom resource detection result of a node until the node reboots or the result is "+
+			"invalidated, instead of reading the custom resource again after --kata-cr-cache-ttl. For clusters "+
+			"where the Kata enablement of a node does not change after boot")
+	kata.defaultLabelValue = flag.String("kata-default-label-value", "",
+		fmt.Sprintf("Value of the '%s' label written to nodes whose Kata detection never succeeded (e.g. unknown). "+
+			"If empty, the label is left absent until detection succeeds", labeler.KataEnabledLabel))
+	falseLabelMode = flag.String("detection-false-label", labeler.DetectionFalseLabelSet,
+		fmt.Sprintf("How negative Kata and runtime feature detections are labeled: '%s' sets the label to false, "+
+			"'%s' removes it", labeler.DetectionFalseLabelSet, labeler.DetectionFalseLabelDelete))
+	cacheSyncAttempts = flag.Int("cache-sync-attempts", labeler.DefaultCacheSyncAttempts,
+		"Number of attempts to wait for the informer caches to sync before giving up")
+	cacheSyncTimeout = flag.Duration("cache-sync-timeout", labeler.DefaultCacheSyncTimeout,
+		"Timeout of the first cache sync attempt; each retry doubles it")
+	kataCR.resource = flag.String("kata-cr-resource", "",
+		"Custom resource to read Kata enablement from, as resource.version.group "+
+			"(e.g. clusterpolicies.v1.nvidia.com). Empty disables custom resource detection")
+	kataCR.namespace = flag.String("kata-cr-namespace", "",
+		"Namespace of the Kata custom resource. Empty for cluster-scoped resources")
+	kataCR.name = flag.String("kata-cr-name", "",
+		fmt.Sprintf("Name of the Kata custom resource. '%s' is replaced with the node name",
+			labeler.KataCRNodeNamePlaceholder))
+	kataCR.fieldPath = flag.String("kata-cr-field-path", "",
+		"Dot-separated path of the field enabling Kata in the custom resource (e.g. spec.sandboxWorkloads.enabled)")
+	kataCR.maxConcurrentDetections = flag.Int("kata-cr-max-concurrent-detections",
+		labeler.DefaultMaxConcurrentKataDetections,
+		"Maximum number of Kata custom resource lookups to run concurrently; detections over the limit wait")
+	kataCR.detectionTimeout = flag.Duration("kata-cr-detection-timeout", labeler.DefaultKataDetectionTimeout,
+		"Timeout of a single Kata custom resource detection, including the wait for a free detection slot")
+	kataCR.cacheTTL = flag.Duration("kata-cr-cache-ttl", labeler.DefaultKataCRCacheTTL,
+		"How long the Kata custom resource detection result of a node is reused before the resource is read again")
+	kataCR.missingCacheTTL = flag.Duration("kata-cr-missing-cache-ttl", labeler.DefaultKataCRMissingCacheTTL,
+		"How long the result of a node whose Kata custom resource was not found is reused, e.g. while the API server "+
+			"watch cache catches up. Capped by --kata-cr-cache-ttl")
+	kataCR.resultOnTimeout = flag.Bool("kata-cr-result-on-timeout", false,
+		"Treat a timed out Kata custom resource detection as unknown rather than a failure: the runtime feature "+
+			"labels of the node are still written and its kata label is left unchanged")
+
+	maintenance.annotation = flag.String("maintenance-annotation", "",
+		fmt.Sprintf("Node annotation marking a node under maintenance; the '%s' label is removed while it is present",
+			labeler.DriverInstalledLabel))
+	maintenance.taintKey = flag.String("maintenance-taint", "",
+		fmt.Sprintf("Node taint key marking a node under maintenance; the '%s' label is removed while it is present",
+			labeler.DriverInstalledLabel))
+
+	driverSource.source = flag.String("driver-source", labeler.DriverSourcePod,
+		fmt.Sprintf("Where the '%s' label is derived from: '%s' uses the readiness of the driver pods, '%s' uses "+
+			"--driver-node-condition or --driver-node-label for nodes with a preinstalled driver",
+			labeler.DriverInstalledLabel, labeler.DriverSourcePod, labeler.DriverSourceNode))
+	driverSource.condition = flag.String("driver-node-condition", "",
+		fmt.Sprintf("Node condition type whose True status marks the driver as installed with --driver-source=%s",
+			labeler.DriverSourceNode))
+	driverSource.label = flag.String("driver-node-label", "",
+		fmt.Sprintf("Node label whose truthy value marks the driver as installed with --driver-source=%s",
+			labeler.DriverSourceNode))
+
+	operatorGate.enabled = flag.Bool("wait-for-gpu-operator", false,
+		"Wait for the DCGM and driver DaemonSets to exist before labeling, to avoid label flapping during cluster bootstrap")
+	operatorGate.namespace = flag.String("gpu-operator-namespace", "",
+		"Namespace of the DCGM and driver DaemonSets waited for. Empty looks in all namespaces")
+	operatorGate.timeout = flag.Duration("gpu-operator-wait-timeout", 0,
+		"Maximum time to wait for the DCGM and driver DaemonSets before labeling anyway. Zero waits indefinitely")
+
+	runtimeFeatures = &[]string{}
+	flag.Func("runtime-feature",
+		fmt.Sprintf("Node runtime feature to detect, as name=key,..., written to the '%s' label. "+
+			"Keys are node labels, or node annotations when prefixed with 'annotation:'. May be repeated",
+			labeler.RuntimeFeatureLabel("<name>")),
+		func(value string) error {
+			*runtimeFeatures = append(*runtimeFeatures, value)
+			return nil
+		})
 
 	flag.Parse()
 
 	return
 }
+
+// splitAppLabels splits a comma-separated list of app label values, dropping empty entries
+func splitAppLabels(value string) []string {
+	var apps []string
+
+	for _, app := range strings.Split(value, ",") {
+		if app = strings.TrimSpace(app); app != "" {
+			apps = append(apps, app)
+		}
+	}
+
+	return apps
+}
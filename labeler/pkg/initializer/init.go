@@ -20,15 +20,70 @@ import (
 	"time"
 
 	"github.com/nvidia/nvsentinel/labeler/pkg/labeler"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 type InitializationParams struct {
-	KubeconfigPath string
-	DCGMAppLabel   string
-	DriverAppLabel string
-	KataLabel      string
+	KubeconfigPath  string
+	DCGMAppLabels   []string
+	DriverAppLabels []string
+	KataLabel       string
+	// KataExtendedResource detects Kata from a node extended resource; empty disables it
+	KataExtendedResource string
+	// KataRequireLabelCorroboration only reports Kata from a kata label when the extended resource
+	// or custom resource detection confirms it
+	KataRequireLabelCorroboration bool
+	// KataObserveOnly runs Kata detection and records its metrics without writing the kata label
+	KataObserveOnly bool
+	// KataDetectOnce keeps the custom resource detection result of a node until it is invalidated
+	KataDetectOnce bool
+	// KataRuntimeClassDetection detects Kata from the node selectors of Kata RuntimeClasses
+	KataRuntimeClassDetection bool
+	// CacheSyncAttempts and CacheSyncTimeout tune the labeler cache sync retry; zero keeps the defaults
+	CacheSyncAttempts int
+	CacheSyncTimeout  time.Duration
+	// KataCRResource enables Kata detection from a custom resource ("resource.version.group");
+	// empty disables it. KataCRName may contain the node name placeholder.
+	KataCRResource  string
+	KataCRNamespace string
+	KataCRName      string
+	KataCRFieldPath string
+	// MaxConcurrentKataDetections bounds concurrent custom resource lookups; zero keeps the default
+	MaxConcurrentKataDetections int
+	// KataDetectionTimeout bounds each custom resource lookup; zero keeps the default
+	KataDetectionTimeout time.Duration
+	// KataCRCacheTTL is how long a node's custom resource lookup is reused; zero keeps the default
+	KataCRCacheTTL time.Duration
+	// KataCRMissingCacheTTL is how long the lookup of a missing custom resource is reused; zero keeps the default
+	KataCRMissingCacheTTL time.Duration
+	// KataResultOnTimeout treats a timed out custom resource lookup as an unknown result rather
+	// than a failed detection
+	KataResultOnTimeout bool
+	// KataDefaultLabelValue is written to nodes whose Kata detection never succeeded; empty leaves
+	// the label absent
+	KataDefaultLabelValue string
+	// MaintenanceAnnotation and MaintenanceTaintKey mark nodes whose ready-implying labels are
+	// suppressed; both empty disables maintenance mode
+	MaintenanceAnnotation string
+	MaintenanceTaintKey   string
+	// DriverSource selects where the driver installed label is derived from, see
+	// labeler.SetDriverSource; DriverNodeCondition and DriverNodeLabel are used by the node source
+	DriverSource        string
+	DriverNodeCondition string
+	DriverNodeLabel     string
+	// RuntimeFeatures are "name=key,..." runtime feature detections, see labeler.ParseRuntimeFeature
+	RuntimeFeatures []string
+	// WaitForGPUOperator defers labeling until the DCGM and driver DaemonSets exist in
+	// GPUOperatorNamespace (all namespaces when empty), for at most GPUOperatorWaitTimeout when set
+	WaitForGPUOperator     bool
+	GPUOperatorNamespace   string
+	GPUOperatorWaitTimeout time.Duration
+	// DetectionFalseLabelMode selects whether negative detections set "false" or remove the label;
+	// empty keeps the default
+	DetectionFalseLabelMode string
 }
 
 type Components struct {
@@ -38,7 +93,12 @@ type Components struct {
 func InitializeAll(params InitializationParams) (*Components, error) {
 	slog.Info("Starting labeler module initialization")
 
-	clientSet, err := initializeKubernetesClient(params.KubeconfigPath)
+	config, err := clientcmd.BuildConfigFromFlags("", params.KubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build config: %w", err)
+	}
+
+	clientSet, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing kubernetes client: %w", err)
 	}
@@ -47,15 +107,76 @@ func InitializeAll(params InitializationParams) (*Components, error) {
 
 	labelerInstance, err := labeler.NewLabeler(
 		clientSet,
-		30*time.Second,
-		params.DCGMAppLabel,
-		params.DriverAppLabel,
+		labeler.DefaultResyncPeriod,
+		params.DCGMAppLabels,
+		params.DriverAppLabels,
 		params.KataLabel,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating labeler instance: %w", err)
 	}
 
+	labelerInstance.SetCacheSyncRetry(params.CacheSyncAttempts, params.CacheSyncTimeout)
+	labelerInstance.SetMaxConcurrentKataDetections(params.MaxConcurrentKataDetections)
+	labelerInstance.SetKataDetectionTimeout(params.KataDetectionTimeout)
+	labelerInstance.SetKataCRCacheTTL(params.KataCRCacheTTL)
+	labelerInstance.SetKataCRMissingCacheTTL(params.KataCRMissingCacheTTL)
+	labelerInstance.SetKataDetectionResultOnTimeout(params.KataResultOnTimeout)
+	labelerInstance.SetKataLabelCorroboration(params.KataRequireLabelCorroboration)
+	labelerInstance.SetKataObserveOnly(params.KataObserveOnly)
+	labelerInstance.SetKataDetectOnce(params.KataDetectOnce)
+
+	if err := labelerInstance.SetKataRuntimeClassDetection(params.KataRuntimeClassDetection); err != nil {
+		return nil, fmt.Errorf("error configuring kata runtime class detection: %w", err)
+	}
+
+	if err := labelerInstance.SetKataExtendedResource(params.KataExtendedResource); err != nil {
+		return nil, fmt.Errorf("error configuring kata extended resource: %w", err)
+	}
+
+	if err := labelerInstance.SetKataDefaultLabelValue(params.KataDefaultLabelValue); err != nil {
+		return nil, fmt.Errorf("error configuring kata default label value: %w", err)
+	}
+
+	if err := labelerInstance.SetDetectionFalseLabelMode(params.DetectionFalseLabelMode); err != nil {
+		return nil, fmt.Errorf("error configuring detection false label mode: %w", err)
+	}
+
+	labelerInstance.SetMaintenanceMarker(labeler.MaintenanceMarker{
+		Annotation: params.MaintenanceAnnotation,
+		TaintKey:   params.MaintenanceTaintKey,
+	})
+
+	if err := labelerInstance.SetDriverSource(params.DriverSource, labeler.DriverNodeMarker{
+		Condition: params.DriverNodeCondition,
+		Label:     params.DriverNodeLabel,
+	}); err != nil {
+		return nil, fmt.Errorf("error configuring driver source: %w", err)
+	}
+
+	if err := initializeRuntimeFeatures(labelerInstance, params.RuntimeFeatures); err != nil {
+		return nil, fmt.Errorf("error configuring runtime feature detection: %w", err)
+	}
+
+	if params.WaitForGPUOperator {
+		labelerInstance.SetOperatorGate(&labeler.OperatorGate{
+			Namespace: params.GPUOperatorNamespace,
+			Timeout:   params.GPUOperatorWaitTimeout,
+		})
+	}
+
+	if params.KataCRResource != "" {
+		if err := initializeKataCRSource(labelerInstance, config, params); err != nil {
+			return nil, fmt.Errorf("error configuring kata custom resource detection: %w", err)
+		}
+
+		slog.Info("Enabled kata detection from custom resource",
+			"resource", params.KataCRResource,
+			"name", params.KataCRName,
+			"fieldPath", params.KataCRFieldPath,
+		)
+	}
+
 	slog.Info("Initialization completed successfully")
 
 	return &Components{
@@ -63,16 +184,49 @@ func InitializeAll(params InitializationParams) (*Components, error) {
 	}, nil
 }
 
-func initializeKubernetesClient(kubeconfigPath string) (kubernetes.Interface, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+func initializeKataCRSource(l *labeler.Labeler, config *rest.Config, params InitializationParams) error {
+	gvr, err := labeler.ParseKataCRResource(params.KataCRResource)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build config: %w", err)
+		return err
 	}
 
-	clientSet, err := kubernetes.NewForConfig(config)
+	dynamicClient, err := dynamic.NewForConfig(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create kubernetes clientset: %w", err)
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	return l.SetKataCRSource(dynamicClient, labeler.KataCRSource{
+		Resource:  gvr,
+		Namespace: params.KataCRNamespace,
+		Name:      params.KataCRName,
+		FieldPath: params.KataCRFieldPath,
+	})
+}
+
+func initializeRuntimeFeatures(l *labeler.Labeler, specs []string) error {
+	features := make([]labeler.RuntimeFeature, 0, len(specs))
+
+	for _, spec := range specs {
+		feature, err := labeler.ParseRuntimeFeature(spec)
+		if err != nil {
+			return err
+		}
+
+		features = append(features, feature)
+	}
+
+	if err := l.SetRuntimeFeatures(features); err != nil {
+		return err
+	}
+
+	for _, feature := range features {
+		slog.Info("Enabled runtime feature detection",
+			"feature", feature.Name,
+			"label", labeler.RuntimeFeatureLabel(feature.Name),
+			"nodeLabels", feature.Labels,
+			"nodeAnnotations", feature.Annotations,
+		)
 	}
 
-	return clientSet, nil
+	return nil
 }
@@ -0,0 +1,64 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// RuntimeLabel is the node label the detected container runtime name is written to
+const RuntimeLabel = "nvsentinel.dgxc.nvidia.com/runtime"
+
+// kataRuntimeMarker marks a Kata build in a container runtime name or version, e.g. containerd://1.6.2-kata
+const kataRuntimeMarker = "kata"
+
+// ContainerRuntime is a container runtime parsed from a node ContainerRuntimeVersion
+type ContainerRuntime struct {
+	// Name is the runtime name, e.g. containerd, docker or cri-o
+	Name string
+	// Version is the runtime version, e.g. 1.6.2-kata
+	Version string
+	// Kata is set when the name or version carries a kata marker
+	Kata bool
+}
+
+// ParseContainerRuntime parses a ContainerRuntimeVersion of the form "name://version", e.g.
+// "containerd://1.6.2-kata" into the name "containerd", the version "1.6.2-kata" and the kata
+// marker. A version without a scheme only has a name.
+func ParseContainerRuntime(runtimeVersion string) ContainerRuntime {
+	name, version, _ := strings.Cut(strings.TrimSpace(runtimeVersion), "://")
+	name = strings.ToLower(name)
+
+	return ContainerRuntime{
+		Name:    name,
+		Version: version,
+		Kata: strings.Contains(name, kataRuntimeMarker) ||
+			strings.Contains(strings.ToLower(version), kataRuntimeMarker),
+	}
+}
+
+// detectContainerRuntime returns the container runtime the node reports. A name that is not a
+// valid label value is dropped, so that the runtime label is removed rather than rejected.
+func detectContainerRuntime(node *v1.Node) ContainerRuntime {
+	runtime := ParseContainerRuntime(node.Status.NodeInfo.ContainerRuntimeVersion)
+	if len(validation.IsValidLabelValue(runtime.Name)) > 0 {
+		runtime.Name = ""
+	}
+
+	return runtime
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseContainerRuntime(t *testing.T) {
+	tests := []struct {
+		name           string
+		runtimeVersion string
+		expected       ContainerRuntime
+	}{
+		{
+			name:           "containerd",
+			runtimeVersion: "containerd://1.7.2",
+			expected:       ContainerRuntime{Name: "containerd", Version: "1.7.2"},
+		},
+		{
+			name:           "docker",
+			runtimeVersion: "docker://20.10.21",
+			expected:       ContainerRuntime{Name: "docker", Version: "20.10.21"},
+		},
+		{
+			name:           "containerd kata build",
+			runtimeVersion: "containerd://1.6.2-kata",
+			expected:       ContainerRuntime{Name: "containerd", Version: "1.6.2-kata", Kata: true},
+		},
+		{
+			name:           "kata runtime",
+			runtimeVersion: "kata://3.2.0",
+			expected:       ContainerRuntime{Name: "kata", Version: "3.2.0", Kata: true},
+		},
+		{
+			name:           "name is lowercased",
+			runtimeVersion: "CRI-O://1.28.1",
+			expected:       ContainerRuntime{Name: "cri-o", Version: "1.28.1"},
+		},
+		{
+			name:           "no scheme",
+			runtimeVersion: "containerd",
+			expected:       ContainerRuntime{Name: "containerd"},
+		},
+		{
+			name:           "empty",
+			runtimeVersion: "",
+			expected:       ContainerRuntime{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseContainerRuntime(tt.runtimeVersion))
+		})
+	}
+}
+
+func TestContainerRuntimeLabel(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+		Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{
+			ContainerRuntimeVersion: "containerd://1.6.2-kata",
+		}},
+	}
+	clientset := fake.NewSimpleClientset(node)
+	ctx := context.Background()
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	detection, err := l.detectKata(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, "containerd", detection.Runtime)
+	assert.True(t, detection.RuntimeKata)
+	assert.False(t, detection.IsKata, "the kata marker must not change the kata detection")
+
+	require.NoError(t, l.handleNodeEvent(node))
+
+	updated := getTestNode(t, clientset, node.Name)
+	assert.Equal(t, "containerd", updated.Labels[RuntimeLabel])
+
+	// A runtime name that is not a valid label value removes the label
+	updated.Status.NodeInfo.ContainerRuntimeVersion = "not a label value://1.0"
+	updated, err = clientset.CoreV1().Nodes().Update(ctx, updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, l.handleNodeEvent(updated))
+
+	updated = getTestNode(t, clientset, node.Name)
+	assert.NotContains(t, updated.Labels, RuntimeLabel)
+}
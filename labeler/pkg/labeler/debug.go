@@ -0,0 +1,79 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// CacheDumpPath is the path CacheDumpHandler is served at
+const CacheDumpPath = "/debug/caches"
+
+// CacheDump is a point-in-time copy of the labeler caches, for field debugging
+type CacheDump struct {
+	// KataCR is the custom resource Kata detection cache
+	KataCR KataCRCacheDump `json:"kataCR"`
+	// Detections is the last detection result of each node
+	Detections map[string]DetectionResult `json:"detections"`
+}
+
+// KataCRCacheDump is a copy of the unexpired custom resource Kata detection results
+type KataCRCacheDump struct {
+	TTL        string                 `json:"ttl"`
+	MissingTTL string                 `json:"missingTTL"`
+	Entries    []KataCRCacheEntryDump `json:"entries"`
+}
+
+// KataCRCacheEntryDump is the cached custom resource Kata detection result of a node
+type KataCRCacheEntryDump struct {
+	Node    string `json:"node"`
+	Enabled bool   `json:"enabled"`
+	// LowConfidence is set for the results of missing custom resources, cached for the missing TTL
+	LowConfidence bool `json:"lowConfidence,omitempty"`
+	// LastLookupHit is set when the last detection of the node was served from the cache
+	LastLookupHit bool   `json:"lastLookupHit"`
+	Age           string `json:"age"`
+	ExpiresIn     string `json:"expiresIn"`
+}
+
+// DumpCaches returns a copy of the labeler caches
+func (l *Labeler) DumpCaches() CacheDump {
+	return CacheDump{
+		KataCR:     l.kataCRResults.dump(time.Now()),
+		Detections: l.detections.dump(),
+	}
+}
+
+// CacheDumpHandler serves DumpCaches as JSON. The dump lists node names and detection results, so
+// it should only be exposed where the metrics are.
+func (l *Labeler) CacheDumpHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(l.DumpCaches()); err != nil {
+			slog.Warn("Failed to write the cache dump", "error", err)
+		}
+	})
+}
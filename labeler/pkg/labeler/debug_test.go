@@ -0,0 +1,88 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCacheDumpHandler(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.kataCRResults.set("node-1", true, false)
+	l.kataCRResults.set("node-2", false, false)
+	l.detections.observe("node-1", DetectionResult{
+		IsKata:   true,
+		Method:   DetectionMethodCustomResource,
+		Features: map[string]bool{"gvisor": false},
+	})
+
+	// Backdate the node-1 entry so that its age shows
+	l.kataCRResults.mu.Lock()
+	entry := l.kataCRResults.entries["node-1"]
+	entry.cachedAt = entry.cachedAt.Add(-5 * time.Minute)
+	entry.expiresAt = entry.expiresAt.Add(-5 * time.Minute)
+	l.kataCRResults.entries["node-1"] = entry
+	l.kataCRResults.mu.Unlock()
+
+	recorder := httptest.NewRecorder()
+	l.CacheDumpHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, CacheDumpPath, nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var dump CacheDump
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &dump))
+
+	assert.Equal(t, DefaultKataCRCacheTTL.String(), dump.KataCR.TTL)
+	require.Len(t, dump.KataCR.Entries, 2)
+	assert.Equal(t, KataCRCacheEntryDump{Node: "node-1", Enabled: true, Age: "5m0s", ExpiresIn: "10m0s"},
+		dump.KataCR.Entries[0])
+	assert.Equal(t, "node-2", dump.KataCR.Entries[1].Node)
+	assert.False(t, dump.KataCR.Entries[1].Enabled)
+	assert.Equal(t, map[string]DetectionResult{
+		"node-1": {IsKata: true, Method: DetectionMethodCustomResource, Features: map[string]bool{"gvisor": false}},
+	}, dump.Detections)
+}
+
+func TestCacheDumpHandler_SkipsExpiredEntries(t *testing.T) {
+	c := newKataCRCache(time.Minute)
+	c.set("node-1", true, false)
+
+	dump := c.dump(time.Now().Add(2 * time.Minute))
+	assert.Equal(t, "1m0s", dump.TTL)
+	assert.Empty(t, dump.Entries)
+}
+
+func TestCacheDumpHandler_RejectsOtherMethods(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	l.CacheDumpHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, CacheDumpPath, nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
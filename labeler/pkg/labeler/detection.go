@@ -0,0 +1,449 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+)
+
+// Kata detection methods reported in DetectionResult.Method
+const (
+	DetectionMethodNone           = "none"
+	DetectionMethodLabel          = "label"
+	DetectionMethodCustomResource = "customResource"
+	// DetectionMethodExtendedResource reports Kata from a node extended resource, see SetKataExtendedResource
+	DetectionMethodExtendedResource = "extendedResource"
+	// DetectionMethodRuntimeClass reports Kata from a RuntimeClass, see SetKataRuntimeClassDetection
+	DetectionMethodRuntimeClass = "runtimeClass"
+
+	// KataStatusChangedReason is the reason of the node Event emitted when the Kata status flips
+	KataStatusChangedReason = "KataStatusChanged"
+	// EventSourceComponent is the source component of Events emitted by the labeler
+	EventSourceComponent = "nvsentinel-labeler"
+)
+
+// Handling of negative detection results, see SetDetectionFalseLabelMode
+const (
+	// DetectionFalseLabelSet writes "false" to the label of a negative result
+	DetectionFalseLabelSet = "set-false"
+	// DetectionFalseLabelDelete removes the label of a negative result
+	DetectionFalseLabelDelete = "delete"
+)
+
+// DetectionResult is the outcome of Kata detection for a node
+type DetectionResult struct {
+	IsKata bool `json:"isKata"`
+	// Method is the detection method that reported Kata, or DetectionMethodNone
+	Method string `json:"method"`
+	// Features reports whether each configured runtime feature was detected, by feature name
+	Features map[string]bool `json:"features,omitempty"`
+	// Timeout is set when the Kata detection timed out and IsKata is unknown, see
+	// SetKataDetectionResultOnTimeout
+	Timeout bool `json:"timeout,omitempty"`
+	// Runtime is the container runtime name the node reports, e.g. containerd, written to RuntimeLabel
+	Runtime string `json:"runtime,omitempty"`
+	// RuntimeKata is set when the container runtime version carries a kata marker. It is reported
+	// alongside the runtime name and does not affect IsKata.
+	RuntimeKata bool `json:"runtimeKata,omitempty"`
+}
+
+// labelValue returns the kata.enabled label value for the result
+func (r DetectionResult) labelValue() string {
+	if r.IsKata {
+		return LabelValueTrue
+	}
+
+	return LabelValueFalse
+}
+
+// detectionLabels returns the kata label, the runtime label and one label per configured runtime
+// feature for the result. An empty value means the label is removed, which is how negative results
+// are written in the DetectionFalseLabelDelete mode and how an unknown runtime is written.
+func (l *Labeler) detectionLabels(r DetectionResult) map[string]string {
+	labels := map[string]string{
+		KataEnabledLabel: l.detectionLabelValue(r.IsKata),
+		RuntimeLabel:     r.Runtime,
+	}
+
+	for name, detected := range r.Features {
+		labels[RuntimeFeatureLabel(name)] = l.detectionLabelValue(detected)
+	}
+
+	if l.kataObserveOnly {
+		delete(labels, KataEnabledLabel)
+	}
+
+	return labels
+}
+
+// detectionLabelValue returns the label value written for a detection outcome
+func (l *Labeler) detectionLabelValue(detected bool) string {
+	if !detected && l.falseLabelMode == DetectionFalseLabelDelete {
+		return ""
+	}
+
+	return strconv.FormatBool(detected)
+}
+
+// DetectionDiff reports which parts of a DetectionResult changed between two observations
+type DetectionDiff struct {
+	KataChanged   bool
+	MethodChanged bool
+}
+
+// Changed reports whether anything changed
+func (d DetectionDiff) Changed() bool {
+	return d.KataChanged || d.MethodChanged
+}
+
+// CompareDetectionResults compares the previous and current detection results of a node
+func CompareDetectionResults(previous, current DetectionResult) DetectionDiff {
+	return DetectionDiff{
+		KataChanged:   previous.IsKata != current.IsKata,
+		MethodChanged: previous.Method != current.Method,
+	}
+}
+
+// detectionTracker remembers the last Kata detection result of each node so that changes can
+// be reported once rather than on every detection
+type detectionTracker struct {
+	mu      sync.Mutex
+	results map[string]DetectionResult
+}
+
+func newDetectionTracker() *detectionTracker {
+	return &detectionTracker{results: make(map[string]DetectionResult)}
+}
+
+// observe records the current result for the node and returns the previous one, if any
+func (t *detectionTracker) observe(nodeName string, current DetectionResult) (DetectionResult, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previous, exists := t.results[nodeName]
+	t.results[nodeName] = current
+
+	return previous, exists
+}
+
+// succeeded reports whether a detection result has been recorded for the node
+func (t *detectionTracker) succeeded(nodeName string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, exists := t.results[nodeName]
+
+	return exists
+}
+
+// forget drops the last result of a deleted node
+func (t *detectionTracker) forget(nodeName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.results, nodeName)
+}
+
+// dump returns a copy of the last result of each node
+func (t *detectionTracker) dump() map[string]DetectionResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return maps.Clone(t.results)
+}
+
+// SetKataDetectionResultOnTimeout makes a Kata detection that times out return a result with
+// Timeout set alongside the error, rather than the error alone. The runtime feature labels of
+// such a node are still written from its metadata, and its kata label is left unchanged.
+func (l *Labeler) SetKataDetectionResultOnTimeout(enabled bool) {
+	l.resultOnTimeout = enabled
+}
+
+// SetKataExtendedResource configures an extended resource, e.g. katacontainers.io/kata, that some
+// Kata setups advertise on the node. A positive allocatable or capacity quantity detects Kata. It is
+// checked with the node metadata, without an API call. An empty name, the default, disables it.
+func (l *Labeler) SetKataExtendedResource(name string) error {
+	if name != "" {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid kata extended resource %q: %s", name, strings.Join(errs, "; "))
+		}
+	}
+
+	l.kataExtendedResource = v1.ResourceName(name)
+
+	return nil
+}
+
+// hasExtendedResource reports whether the node advertises a positive quantity of the resource.
+// Allocatable is checked first, falling back to capacity for nodes that only report capacity.
+func hasExtendedResource(node *v1.Node, resource v1.ResourceName) bool {
+	if resource == "" {
+		return false
+	}
+
+	for _, resources := range []v1.ResourceList{node.Status.Allocatable, node.Status.Capacity} {
+		if quantity, exists := resources[resource]; exists {
+			return quantity.Sign() > 0
+		}
+	}
+
+	return false
+}
+
+// SetKataLabelCorroboration makes a kata label report Kata only when the extended resource, the
+// RuntimeClass or the custom resource detection reports it as well, so that a label left behind
+// after Kata was disabled is not trusted on its own. Nodes without a kata label are then never
+// reported as Kata. Disabled, the default, any method reporting Kata is enough.
+func (l *Labeler) SetKataLabelCorroboration(required bool) {
+	l.requireLabelCorroboration = required
+}
+
+// SetKataObserveOnly runs Kata detection and records its metrics without ever writing the kata
+// label, so that detection can be evaluated before it is trusted. The label each node would get is
+// logged instead, while the runtime and runtime feature labels are still written. Disabled, the
+// default, the kata label is written.
+func (l *Labeler) SetKataObserveOnly(enabled bool) {
+	l.kataObserveOnly = enabled
+}
+
+// detectKata detects if Kata is enabled on the specified node by checking node metadata and, if
+// configured, the Kata RuntimeClasses and custom resource, along with the configured runtime
+// features and the container runtime. The methods run one at a time in that order and stop at the
+// first positive one, so the method credited for a node is deterministic and there is no concurrent
+// mode to opt out of. Only the custom resource lookup calls the API server, so a detection makes at
+// most one API call, and concurrent lookups across nodes are bounded by
+// SetMaxConcurrentKataDetections. An error means the custom resource could not be read and the
+// result is unknown. In the result on timeout mode, a timed out lookup also returns a result with
+// Timeout set and the runtime features. Every detection is reported to the detection metrics
+// recorder, which credits the method producing a positive result; a corroborated label credits the
+// method corroborating it.
+func (l *Labeler) detectKata(ctx context.Context, node *v1.Node) (DetectionResult, error) {
+	start := time.Now()
+
+	l.detectionMetrics.RecordAttempt()
+
+	result, err := l.runKataDetection(ctx, node)
+
+	l.detectionMetrics.RecordDuration(time.Since(start))
+	l.detectionMetrics.RecordResult(node.Name, result.Method, err)
+
+	return result, err
+}
+
+// runKataDetection runs the Kata detection methods for detectKata
+func (l *Labeler) runKataDetection(ctx context.Context, node *v1.Node) (DetectionResult, error) {
+	result := DetectionResult{IsKata: false, Method: DetectionMethodNone}
+	labeled := isKataEnabled(node, l.kataLabels)
+
+	switch {
+	case l.requireLabelCorroboration && !labeled:
+		// Without a kata label there is nothing to corroborate
+	case labeled && !l.requireLabelCorroboration:
+		result = newPositiveDetection(DetectionMethodLabel)
+	case hasExtendedResource(node, l.kataExtendedResource):
+		result = newPositiveDetection(DetectionMethodExtendedResource)
+	case l.hasKataRuntimeClass(node):
+		result = newPositiveDetection(DetectionMethodRuntimeClass)
+	case l.kataCRSource != nil:
+		enabled, err := l.isKataEnabledByCR(ctx, node.Name)
+		if err != nil {
+			if l.resultOnTimeout && errors.Is(err, context.DeadlineExceeded) {
+				result = DetectionResult{
+					IsKata:   false,
+					Method:   DetectionMethodNone,
+					Features: detectRuntimeFeatures(node, l.runtimeFeatures),
+					Timeout:  true,
+				}
+				setContainerRuntime(&result, node)
+
+				return result, err
+			}
+
+			return DetectionResult{}, err
+		}
+
+		if enabled {
+			result = newPositiveDetection(DetectionMethodCustomResource)
+		}
+	}
+
+	result.Features = detectRuntimeFeatures(node, l.runtimeFeatures)
+	setContainerRuntime(&result, node)
+
+	return result, nil
+}
+
+// setContainerRuntime records the container runtime the node reports in the result
+func setContainerRuntime(result *DetectionResult, node *v1.Node) {
+	runtime := detectContainerRuntime(node)
+	result.Runtime = runtime.Name
+	result.RuntimeKata = runtime.Kata
+}
+
+// newPositiveDetection returns a Kata result for the method that detected it
+func newPositiveDetection(method string) DetectionResult {
+	return DetectionResult{IsKata: true, Method: method}
+}
+
+// SetKataDefaultLabelValue configures the kata.enabled label value written when Kata detection
+// fails for a node that never had a successful detection and has no kata label yet, e.g.
+// "unknown". It must be a valid label value other than "true" or "false". An empty value, the
+// default, leaves the label absent until a detection succeeds.
+func (l *Labeler) SetKataDefaultLabelValue(value string) error {
+	if strings.EqualFold(value, LabelValueTrue) || strings.EqualFold(value, LabelValueFalse) {
+		return fmt.Errorf("invalid kata default label value %q: must differ from %q and %q",
+			value, LabelValueTrue, LabelValueFalse)
+	}
+
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf("invalid kata default label value %q: %s", value, strings.Join(errs, "; "))
+	}
+
+	l.kataDefaultLabel = value
+
+	return nil
+}
+
+// SetDetectionFalseLabelMode configures how negative Kata and runtime feature detection results
+// are written: DetectionFalseLabelSet, the default, sets the label to "false", while
+// DetectionFalseLabelDelete removes the label so only positive results are labeled. An empty
+// mode keeps the default.
+func (l *Labeler) SetDetectionFalseLabelMode(mode string) error {
+	switch mode {
+	case "":
+		l.falseLabelMode = DetectionFalseLabelSet
+	case DetectionFalseLabelSet, DetectionFalseLabelDelete:
+		l.falseLabelMode = mode
+	default:
+		return fmt.Errorf("invalid detection false label mode %q: must be %q or %q",
+			mode, DetectionFalseLabelSet, DetectionFalseLabelDelete)
+	}
+
+	return nil
+}
+
+// kataLabelOnDetectionError returns the kata.enabled label value to write when detection fails
+// for the node, or "" to leave the label unchanged. A previously detected value is never
+// overwritten; the configured default only fills in the label of nodes that never had one.
+func (l *Labeler) kataLabelOnDetectionError(node *v1.Node) string {
+	if l.kataObserveOnly || l.kataDefaultLabel == "" || l.detections.succeeded(node.Name) {
+		return ""
+	}
+
+	if _, exists := node.Labels[KataEnabledLabel]; exists {
+		return ""
+	}
+
+	return l.kataDefaultLabel
+}
+
+// handleKataDetectionError applies the default kata label, if any, after a failed detection
+// and returns the detection error. The runtime feature labels of a timed out detection result
+// are written too, since they do not depend on the failed lookup.
+func (l *Labeler) handleKataDetectionError(ctx context.Context, node *v1.Node, detection DetectionResult,
+	detectionErr error) error {
+	labels := make(map[string]string)
+
+	if detection.Timeout {
+		labels = l.detectionLabels(detection)
+		delete(labels, KataEnabledLabel)
+	}
+
+	if value := l.kataLabelOnDetectionError(node); value != "" {
+		slog.Info("Kata detection never succeeded for node, applying default kata label",
+			"node", node.Name, "kata", value)
+
+		labels[KataEnabledLabel] = value
+	}
+
+	if len(labels) > 0 && !hasLabels(node, labels) {
+		if err := l.updateDetectionLabels(ctx, node.Name, labels); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("failed to detect kata for node %s: %w", node.Name, detectionErr)
+}
+
+// observeKataDetection compares the result with the last one observed for the node and emits a
+// node Event when the Kata status flips. The first observation of a node only records it.
+func (l *Labeler) observeKataDetection(node *v1.Node, current DetectionResult) {
+	if l.kataObserveOnly {
+		logObservedKataLabel(node, current, l.detectionLabelValue(current.IsKata))
+	}
+
+	previous, exists := l.detections.observe(node.Name, current)
+	if !exists {
+		return
+	}
+
+	diff := CompareDetectionResults(previous, current)
+	if !diff.Changed() {
+		return
+	}
+
+	slog.Info("Kata detection changed",
+		"node", node.Name,
+		"previousKata", previous.IsKata,
+		"kata", current.IsKata,
+		"previousMethod", previous.Method,
+		"method", current.Method,
+	)
+
+	if diff.KataChanged {
+		l.recorder.Eventf(node, v1.EventTypeNormal, KataStatusChangedReason,
+			"Kata enabled changed from %t to %t (detection method: %s)",
+			previous.IsKata, current.IsKata, current.Method)
+	}
+}
+
+// logObservedKataLabel logs the kata label that observe only mode leaves unwritten. Nodes whose
+// label already matches the detection are only logged at debug level.
+func logObservedKataLabel(node *v1.Node, detection DetectionResult, value string) {
+	current, exists := node.Labels[KataEnabledLabel]
+
+	if (value == "" && !exists) || (exists && current == value) {
+		slog.Debug("Kata observe only, kata label matches detection", "node", node.Name, "kata", value)
+		return
+	}
+
+	slog.Info("Kata observe only, leaving kata label unchanged",
+		"node", node.Name,
+		"currentLabel", current,
+		"detectedLabel", value,
+		"method", detection.Method,
+	)
+}
+
+// newEventRecorder returns a recorder for node Events; the broadcaster is started by Run
+func newEventRecorder() (record.EventBroadcaster, record.EventRecorder) {
+	broadcaster := record.NewBroadcaster()
+	recorder := broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: EventSourceComponent})
+
+	return broadcaster, recorder
+}
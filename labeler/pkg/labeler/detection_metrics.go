@@ -0,0 +1,75 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"time"
+
+	"github.com/nvidia/nvsentinel/labeler/pkg/metrics"
+)
+
+// DetectionMetricsRecorder records the metrics of Kata detections, so that they can be shipped to a
+// backend other than Prometheus, e.g. OpenTelemetry or statsd. Implementations must be safe for
+// concurrent use, since nodes are detected concurrently.
+type DetectionMetricsRecorder interface {
+	// RecordAttempt counts a Kata detection about to run
+	RecordAttempt()
+	// RecordDuration records how long a Kata detection took, whatever its outcome
+	RecordDuration(duration time.Duration)
+	// RecordResult records the outcome of the Kata detection of a node: the method that detected
+	// Kata, or DetectionMethodNone, or the error that left the result unknown
+	RecordResult(nodeName, method string, err error)
+}
+
+// SetDetectionMetricsRecorder replaces the Prometheus recorder of the Kata detection metrics. A nil
+// recorder disables them.
+func (l *Labeler) SetDetectionMetricsRecorder(recorder DetectionMetricsRecorder) {
+	if recorder == nil {
+		recorder = noopDetectionMetricsRecorder{}
+	}
+
+	l.detectionMetrics = recorder
+}
+
+// prometheusDetectionRecorder is the default DetectionMetricsRecorder, exposing the Kata detection
+// metrics on the Prometheus registry
+type prometheusDetectionRecorder struct{}
+
+func (prometheusDetectionRecorder) RecordAttempt() {
+	metrics.KataDetectionAttempts.Inc()
+}
+
+func (prometheusDetectionRecorder) RecordDuration(duration time.Duration) {
+	metrics.KataDetectionDuration.Observe(duration.Seconds())
+}
+
+// RecordResult counts failed detections and credits the method of positive ones
+func (prometheusDetectionRecorder) RecordResult(nodeName, method string, err error) {
+	switch {
+	case err != nil:
+		metrics.KataDetectionFailures.Inc()
+	case method != DetectionMethodNone:
+		metrics.KataDetectionMethodWins.WithLabelValues(nodeName, method).Inc()
+	}
+}
+
+// noopDetectionMetricsRecorder drops the Kata detection metrics
+type noopDetectionMetricsRecorder struct{}
+
+func (noopDetectionMetricsRecorder) RecordAttempt() {}
+
+func (noopDetectionMetricsRecorder) RecordDuration(time.Duration) {}
+
+func (noopDetectionMetricsRecorder) RecordResult(string, string, error) {}
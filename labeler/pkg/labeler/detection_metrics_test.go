@@ -0,0 +1,215 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type recordedResult struct {
+	nodeName string
+	method   string
+	err      error
+}
+
+type fakeDetectionRecorder struct {
+	mu        sync.Mutex
+	attempts  int
+	durations []time.Duration
+	results   []recordedResult
+}
+
+func (r *fakeDetectionRecorder) RecordAttempt() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts++
+}
+
+func (r *fakeDetectionRecorder) RecordDuration(duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.durations = append(r.durations, duration)
+}
+
+func (r *fakeDetectionRecorder) RecordResult(nodeName, method string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, recordedResult{nodeName: nodeName, method: method, err: err})
+}
+
+func TestDetectKata_RecordsDetectionMetrics(t *testing.T) {
+	crErr := errors.New("apiserver unavailable")
+
+	tests := []struct {
+		name       string
+		nodeLabels map[string]string
+		crErr      error
+		expected   recordedResult
+	}{
+		{
+			name:       "positive detection",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			expected:   recordedResult{nodeName: "node-1", method: DetectionMethodLabel},
+		},
+		{
+			name:     "negative detection",
+			expected: recordedResult{nodeName: "node-1", method: DetectionMethodNone},
+		},
+		{
+			name:     "failed detection",
+			crErr:    crErr,
+			expected: recordedResult{nodeName: "node-1", err: crErr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+
+			recorder := &fakeDetectionRecorder{}
+			l.SetDetectionMetricsRecorder(recorder)
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("get", "clusterpolicies",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return tt.crErr != nil, nil, tt.crErr
+				})
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.nodeLabels}}
+
+			_, err = l.detectKata(context.Background(), node)
+			assert.ErrorIs(t, err, tt.crErr)
+
+			assert.Equal(t, 1, recorder.attempts)
+			assert.Len(t, recorder.durations, 1)
+			require.Len(t, recorder.results, 1)
+			assert.Equal(t, tt.expected.nodeName, recorder.results[0].nodeName)
+			assert.Equal(t, tt.expected.method, recorder.results[0].method)
+			assert.ErrorIs(t, recorder.results[0].err, tt.expected.err)
+		})
+	}
+}
+
+func TestSetDetectionMetricsRecorder_NilDisablesMetrics(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.SetDetectionMetricsRecorder(nil)
+	assert.Equal(t, noopDetectionMetricsRecorder{}, l.detectionMetrics)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-1",
+		Labels: map[string]string{KataRuntimeDefaultLabel: "true"},
+	}}
+
+	result, err := l.detectKata(context.Background(), node)
+	require.NoError(t, err)
+	assert.True(t, result.IsKata)
+}
+
+func TestHandleNodeEvent_KataObserveOnly(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodeLabels map[string]string
+		crErr      error
+		expected   recordedResult
+	}{
+		{
+			name:       "positive detection leaves label absent",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			expected:   recordedResult{nodeName: "node-1", method: DetectionMethodLabel},
+		},
+		{
+			name:       "positive detection leaves stale label",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true", KataEnabledLabel: LabelValueFalse},
+			expected:   recordedResult{nodeName: "node-1", method: DetectionMethodLabel},
+		},
+		{
+			name:     "negative detection leaves label absent",
+			expected: recordedResult{nodeName: "node-1", method: DetectionMethodNone},
+		},
+		{
+			name:     "failed detection skips default label",
+			crErr:    errors.New("apiserver unavailable"),
+			expected: recordedResult{nodeName: "node-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.nodeLabels}}
+			clientset := fake.NewSimpleClientset(node)
+
+			l, err := NewLabeler(clientset, time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+			require.NoError(t, l.SetKataDefaultLabelValue("unknown"))
+			l.SetKataObserveOnly(true)
+
+			recorder := &fakeDetectionRecorder{}
+			l.SetDetectionMetricsRecorder(recorder)
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("get", "clusterpolicies",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return tt.crErr != nil, nil, tt.crErr
+				})
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			err = l.handleNodeEvent(node)
+			assert.ErrorIs(t, err, tt.crErr)
+
+			assert.Equal(t, 1, recorder.attempts)
+			require.Len(t, recorder.results, 1)
+			assert.Equal(t, tt.expected.method, recorder.results[0].method)
+
+			updated, err := clientset.CoreV1().Nodes().Get(context.Background(), node.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			value, exists := updated.Labels[KataEnabledLabel]
+			expectedValue, expectExists := tt.nodeLabels[KataEnabledLabel]
+			assert.Equal(t, expectExists, exists)
+			assert.Equal(t, expectedValue, value)
+		})
+	}
+}
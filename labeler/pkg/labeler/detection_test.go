@@ -0,0 +1,509 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nvidia/nvsentinel/labeler/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestCompareDetectionResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous DetectionResult
+		current  DetectionResult
+		expected DetectionDiff
+	}{
+		{
+			name:     "no change",
+			previous: DetectionResult{IsKata: true, Method: DetectionMethodLabel},
+			current:  DetectionResult{IsKata: true, Method: DetectionMethodLabel},
+			expected: DetectionDiff{},
+		},
+		{
+			name:     "kata enabled",
+			previous: DetectionResult{IsKata: false, Method: DetectionMethodNone},
+			current:  DetectionResult{IsKata: true, Method: DetectionMethodLabel},
+			expected: DetectionDiff{KataChanged: true, MethodChanged: true},
+		},
+		{
+			name:     "kata flip with the same method",
+			previous: DetectionResult{IsKata: true, Method: DetectionMethodLabel},
+			current:  DetectionResult{IsKata: false, Method: DetectionMethodLabel},
+			expected: DetectionDiff{KataChanged: true},
+		},
+		{
+			name:     "method change",
+			previous: DetectionResult{IsKata: true, Method: DetectionMethodLabel},
+			current:  DetectionResult{IsKata: true, Method: DetectionMethodCustomResource},
+			expected: DetectionDiff{MethodChanged: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := CompareDetectionResults(tt.previous, tt.current)
+			assert.Equal(t, tt.expected, diff)
+			assert.Equal(t, tt.expected != DetectionDiff{}, diff.Changed())
+		})
+	}
+}
+
+func TestObserveKataDetection_EmitsEventOnKataChange(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	recorder := record.NewFakeRecorder(10)
+	l.recorder = recorder
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	// The first observation only records the result
+	l.observeKataDetection(node, DetectionResult{IsKata: false, Method: DetectionMethodNone})
+	assert.Empty(t, recorder.Events)
+
+	// No change
+	l.observeKataDetection(node, DetectionResult{IsKata: false, Method: DetectionMethodNone})
+	assert.Empty(t, recorder.Events)
+
+	// Kata flip
+	l.observeKataDetection(node, DetectionResult{IsKata: true, Method: DetectionMethodLabel})
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t,
+		"Normal KataStatusChanged Kata enabled changed from false to true (detection method: label)",
+		<-recorder.Events)
+
+	// Method change only
+	l.observeKataDetection(node, DetectionResult{IsKata: true, Method: DetectionMethodCustomResource})
+	assert.Empty(t, recorder.Events)
+
+	// A deleted node starts over
+	l.detections.forget(node.Name)
+	l.observeKataDetection(node, DetectionResult{IsKata: false, Method: DetectionMethodNone})
+	assert.Empty(t, recorder.Events)
+}
+
+func TestSetKataDefaultLabelValue_Validation(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	assert.NoError(t, l.SetKataDefaultLabelValue(""))
+	assert.NoError(t, l.SetKataDefaultLabelValue("unknown"))
+	assert.Error(t, l.SetKataDefaultLabelValue(LabelValueTrue))
+	assert.Error(t, l.SetKataDefaultLabelValue("False"))
+	assert.Error(t, l.SetKataDefaultLabelValue("not a label value"))
+}
+
+func TestHandleNodeEvent_KataDefaultLabelValue(t *testing.T) {
+	tests := []struct {
+		name         string
+		defaultValue string
+		crErr        error
+		nodeLabels   map[string]string
+		detectedOnce bool
+		expectErr    bool
+		expected     string
+		expectLabel  bool
+	}{
+		{
+			name:         "failed detection of a new node writes the default",
+			defaultValue: "unknown",
+			crErr:        fmt.Errorf("api unavailable"),
+			expectErr:    true,
+			expected:     "unknown",
+			expectLabel:  true,
+		},
+		{
+			name:      "failed detection without a default leaves the label absent",
+			crErr:     fmt.Errorf("api unavailable"),
+			expectErr: true,
+		},
+		{
+			name:         "failed detection keeps an existing label",
+			defaultValue: "unknown",
+			crErr:        fmt.Errorf("api unavailable"),
+			nodeLabels:   map[string]string{KataEnabledLabel: LabelValueTrue},
+			expectErr:    true,
+			expected:     LabelValueTrue,
+			expectLabel:  true,
+		},
+		{
+			name:         "failed detection after a successful one keeps the label absent",
+			defaultValue: "unknown",
+			crErr:        fmt.Errorf("api unavailable"),
+			detectedOnce: true,
+			expectErr:    true,
+		},
+		{
+			name:         "successful detection writes false",
+			defaultValue: "unknown",
+			expected:     LabelValueFalse,
+			expectLabel:  true,
+		},
+		{
+			name:         "successful detection writes true",
+			defaultValue: "unknown",
+			nodeLabels:   map[string]string{KataRuntimeDefaultLabel: "true"},
+			expected:     LabelValueTrue,
+			expectLabel:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.nodeLabels}}
+			clientset := fake.NewSimpleClientset(node)
+
+			l, err := NewLabeler(clientset, time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+			require.NoError(t, l.SetKataDefaultLabelValue(tt.defaultValue))
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("get", "clusterpolicies",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return tt.crErr != nil, nil, tt.crErr
+				})
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			if tt.detectedOnce {
+				l.detections.observe(node.Name, DetectionResult{IsKata: false, Method: DetectionMethodNone})
+			}
+
+			err = l.handleNodeEvent(node)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			updated, err := clientset.CoreV1().Nodes().Get(context.Background(), node.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			value, exists := updated.Labels[KataEnabledLabel]
+			assert.Equal(t, tt.expectLabel, exists)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestDetectKata_CreditsWinningMethod(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodeName   string
+		nodeLabels map[string]string
+		crEnabled  bool
+		expected   string
+	}{
+		{
+			name:       "node label wins",
+			nodeName:   "wins-label",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			crEnabled:  true,
+			expected:   DetectionMethodLabel,
+		},
+		{
+			name:      "custom resource wins",
+			nodeName:  "wins-cr",
+			crEnabled: true,
+			expected:  DetectionMethodCustomResource,
+		},
+		{
+			name:     "no method wins",
+			nodeName: "wins-none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+					map[string]any{"sandboxWorkloads": map[string]any{"enabled": tt.crEnabled}}))
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: tt.nodeName, Labels: tt.nodeLabels}}
+
+			_, err = l.detectKata(context.Background(), node)
+			require.NoError(t, err)
+
+			for _, method := range []string{DetectionMethodLabel, DetectionMethodCustomResource} {
+				wins := testutil.ToFloat64(metrics.KataDetectionMethodWins.WithLabelValues(tt.nodeName, method))
+				if method == tt.expected {
+					assert.Equal(t, float64(1), wins, method)
+				} else {
+					assert.Zero(t, wins, method)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectKata_SequentialMethodOrder(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+			map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}))
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "sequential",
+		Labels: map[string]string{KataRuntimeDefaultLabel: "true"},
+	}}
+
+	// Node labels are checked first and a positive label short-circuits the custom resource
+	for range 10 {
+		detection, err := l.detectKata(context.Background(), node)
+		require.NoError(t, err)
+		assert.Equal(t, DetectionResult{IsKata: true, Method: DetectionMethodLabel}, detection)
+	}
+
+	assert.Empty(t, dynamicClient.Actions())
+}
+
+func TestDetectKata_ExtendedResource(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	assert.Error(t, l.SetKataExtendedResource("not a resource"))
+	require.NoError(t, l.SetKataExtendedResource("katacontainers.io/kata"))
+
+	tests := []struct {
+		name        string
+		allocatable corev1.ResourceList
+		capacity    corev1.ResourceList
+		expected    DetectionResult
+	}{
+		{
+			name:        "node advertising the resource",
+			allocatable: corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("1")},
+			capacity:    corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("1")},
+			expected:    DetectionResult{IsKata: true, Method: DetectionMethodExtendedResource},
+		},
+		{
+			name:     "node reporting only capacity",
+			capacity: corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("8")},
+			expected: DetectionResult{IsKata: true, Method: DetectionMethodExtendedResource},
+		},
+		{
+			name:        "node advertising a zero quantity",
+			allocatable: corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("0")},
+			capacity:    corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("0")},
+			expected:    DetectionResult{IsKata: false, Method: DetectionMethodNone},
+		},
+		{
+			name:        "node not advertising the resource",
+			allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			expected:    DetectionResult{IsKata: false, Method: DetectionMethodNone},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "extended-resource"},
+				Status:     corev1.NodeStatus{Allocatable: tt.allocatable, Capacity: tt.capacity},
+			}
+
+			detection, err := l.detectKata(context.Background(), node)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detection)
+		})
+	}
+}
+
+func TestDetectKata_LabelCorroboration(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodeLabels map[string]string
+		crEnabled  bool
+		resources  corev1.ResourceList
+		expected   DetectionResult
+	}{
+		{
+			name:       "stale label only",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			expected:   DetectionResult{IsKata: false, Method: DetectionMethodNone},
+		},
+		{
+			name:       "label corroborated by the custom resource",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			crEnabled:  true,
+			expected:   DetectionResult{IsKata: true, Method: DetectionMethodCustomResource},
+		},
+		{
+			name:       "label corroborated by the extended resource",
+			nodeLabels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			resources:  corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("1")},
+			expected:   DetectionResult{IsKata: true, Method: DetectionMethodExtendedResource},
+		},
+		{
+			name:      "custom resource without a label",
+			crEnabled: true,
+			resources: corev1.ResourceList{"katacontainers.io/kata": resource.MustParse("1")},
+			expected:  DetectionResult{IsKata: false, Method: DetectionMethodNone},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+			l.SetKataLabelCorroboration(true)
+			require.NoError(t, l.SetKataExtendedResource("katacontainers.io/kata"))
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+					map[string]any{"sandboxWorkloads": map[string]any{"enabled": tt.crEnabled}}))
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "corroboration", Labels: tt.nodeLabels},
+				Status:     corev1.NodeStatus{Allocatable: tt.resources},
+			}
+
+			detection, err := l.detectKata(context.Background(), node)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detection)
+		})
+	}
+}
+
+func TestSetDetectionFalseLabelMode_Validation(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, DetectionFalseLabelSet, l.falseLabelMode)
+
+	assert.NoError(t, l.SetDetectionFalseLabelMode(DetectionFalseLabelDelete))
+	assert.Equal(t, DetectionFalseLabelDelete, l.falseLabelMode)
+
+	assert.NoError(t, l.SetDetectionFalseLabelMode(""))
+	assert.Equal(t, DetectionFalseLabelSet, l.falseLabelMode)
+
+	assert.Error(t, l.SetDetectionFalseLabelMode("remove"))
+}
+
+func TestHandleNodeEvent_DetectionFalseLabelMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		expectedLabel string
+		expectPresent bool
+	}{
+		{
+			name:          "set-false writes false",
+			mode:          DetectionFalseLabelSet,
+			expectedLabel: LabelValueFalse,
+			expectPresent: true,
+		},
+		{
+			name:          "delete removes the label",
+			mode:          DetectionFalseLabelDelete,
+			expectPresent: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name: "test-node",
+				Labels: map[string]string{
+					KataRuntimeDefaultLabel: "true",
+					"amd.com/sev":           "true",
+				},
+			}}
+			clientset := fake.NewSimpleClientset(node)
+			ctx := context.Background()
+
+			l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+			require.NoError(t, l.SetDetectionFalseLabelMode(tt.mode))
+			require.NoError(t, l.SetRuntimeFeatures([]RuntimeFeature{
+				{Name: "confidential-compute", Labels: []string{"amd.com/sev"}},
+			}))
+
+			require.NoError(t, l.handleNodeEvent(node))
+
+			updated := getTestNode(t, clientset, node.Name)
+			assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+			assert.Equal(t, LabelValueTrue, updated.Labels[RuntimeFeatureLabel("confidential-compute")])
+
+			// Kata and the runtime feature are disabled on the node
+			delete(updated.Labels, KataRuntimeDefaultLabel)
+			delete(updated.Labels, "amd.com/sev")
+			updated, err = clientset.CoreV1().Nodes().Update(ctx, updated, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			// Detecting the negative result twice is idempotent
+			for range 2 {
+				require.NoError(t, l.handleNodeEvent(updated))
+				updated = getTestNode(t, clientset, node.Name)
+
+				for _, label := range []string{KataEnabledLabel, RuntimeFeatureLabel("confidential-compute")} {
+					value, exists := updated.Labels[label]
+					assert.Equal(t, tt.expectPresent, exists, label)
+					assert.Equal(t, tt.expectedLabel, value, label)
+				}
+			}
+
+			// ReconcileNode applies the same handling
+			require.NoError(t, l.ReconcileNode(ctx, node.Name))
+			_, exists := getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel]
+			assert.Equal(t, tt.expectPresent, exists)
+		})
+	}
+}
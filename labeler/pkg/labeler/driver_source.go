@@ -0,0 +1,101 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Sources of the driver.installed label, see SetDriverSource
+const (
+	// DriverSourcePod labels a node as having a driver installed while a driver pod on it is ready
+	DriverSourcePod = "pod"
+	// DriverSourceNode labels a node as having a driver installed from a node condition or label,
+	// for nodes with a preinstalled driver that does not run as a pod
+	DriverSourceNode = "node"
+)
+
+// DriverNodeMarker identifies nodes with an installed driver when the driver source is
+// DriverSourceNode. A node whose condition of type Condition is True, or whose Label has a truthy
+// value, has a driver installed; empty fields are not checked.
+type DriverNodeMarker struct {
+	Condition string
+	Label     string
+}
+
+// SetDriverSource configures where the driver.installed label is derived from: DriverSourcePod,
+// the default, uses the readiness of the driver pods, while DriverSourceNode uses the node
+// condition or label of marker. An empty source keeps the default.
+func (l *Labeler) SetDriverSource(source string, marker DriverNodeMarker) error {
+	switch source {
+	case "", DriverSourcePod:
+		l.driverSource = DriverSourcePod
+	case DriverSourceNode:
+		if marker.Condition == "" && marker.Label == "" {
+			return fmt.Errorf("driver source %q requires a node condition or label", DriverSourceNode)
+		}
+
+		l.driverSource = DriverSourceNode
+	default:
+		return fmt.Errorf("invalid driver source %q: must be %q or %q", source, DriverSourcePod, DriverSourceNode)
+	}
+
+	l.driverNodeMarker = marker
+
+	return nil
+}
+
+// getDriverLabelFromNode returns the expected driver label value of a node from its driver
+// condition or label, looked up in the node informer cache
+func (l *Labeler) getDriverLabelFromNode(nodeName string) (string, error) {
+	obj, exists, err := l.nodeInformer.GetIndexer().GetByKey(nodeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get node %s from cache: %w", nodeName, err)
+	}
+
+	if !exists {
+		return "", nil
+	}
+
+	node, ok := obj.(*v1.Node)
+	if !ok {
+		return "", fmt.Errorf("expected Node object for %s, got %T", nodeName, obj)
+	}
+
+	if l.hasDriverNodeMarker(node) {
+		return LabelValueTrue, nil
+	}
+
+	return "", nil
+}
+
+// hasDriverNodeMarker returns true if the node carries the configured driver condition or label
+func (l *Labeler) hasDriverNodeMarker(node *v1.Node) bool {
+	if l.driverNodeMarker.Condition != "" {
+		for _, condition := range node.Status.Conditions {
+			if string(condition.Type) == l.driverNodeMarker.Condition && condition.Status == v1.ConditionTrue {
+				return true
+			}
+		}
+	}
+
+	if l.driverNodeMarker.Label != "" {
+		return hasTruthyKey(node, "label", node.Labels, []string{l.driverNodeMarker.Label})
+	}
+
+	return false
+}
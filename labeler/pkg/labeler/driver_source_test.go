@@ -0,0 +1,143 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const driverNodeCondition = "DriverReady"
+
+func newDriverSourceTestLabeler(t *testing.T, node *corev1.Node, source string) (*Labeler, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.SetDriverSource(source, DriverNodeMarker{
+		Condition: driverNodeCondition,
+		Label:     "example.com/driver.preinstalled",
+	}))
+	require.NoError(t, l.nodeInformer.GetIndexer().Add(node))
+
+	return l, clientset
+}
+
+func TestDriverSource_LabelsDriverInstalled(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		node   *corev1.Node
+		pod    *corev1.Pod
+	}{
+		{
+			name:   "ready driver pod",
+			source: DriverSourcePod,
+			node:   &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "driver-pod",
+					UID:    "driver-uid",
+					Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+				},
+				Spec: corev1.PodSpec{NodeName: "test-node"},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			},
+		},
+		{
+			name:   "node condition",
+			source: DriverSourceNode,
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: driverNodeCondition, Status: corev1.ConditionTrue},
+				}},
+			},
+		},
+		{
+			name:   "node label",
+			source: DriverSourceNode,
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-node",
+				Labels: map[string]string{"example.com/driver.preinstalled": "yes"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, clientset := newDriverSourceTestLabeler(t, tt.node, tt.source)
+
+			if tt.pod != nil {
+				require.NoError(t, l.podInformer.GetIndexer().Add(tt.pod))
+			}
+
+			require.NoError(t, l.ReconcileNode(context.Background(), tt.node.Name))
+			assert.Equal(t, LabelValueTrue, getTestNode(t, clientset, tt.node.Name).Labels[DriverInstalledLabel])
+		})
+	}
+}
+
+func TestDriverSource_NodeIgnoresDriverPods(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-node",
+			Labels: map[string]string{DriverInstalledLabel: LabelValueTrue},
+		},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: driverNodeCondition, Status: corev1.ConditionFalse},
+		}},
+	}
+	l, clientset := newDriverSourceTestLabeler(t, node, DriverSourceNode)
+
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "driver-pod",
+			UID:    "driver-uid",
+			Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+		},
+		Spec: corev1.PodSpec{NodeName: node.Name},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}))
+
+	require.NoError(t, l.handleNodeEvent(node))
+	assert.NotContains(t, getTestNode(t, clientset, node.Name).Labels, DriverInstalledLabel)
+}
+
+func TestSetDriverSource_Validation(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.SetDriverSource("", DriverNodeMarker{}))
+	assert.Equal(t, DriverSourcePod, l.driverSource)
+
+	assert.Error(t, l.SetDriverSource(DriverSourceNode, DriverNodeMarker{}))
+	assert.Error(t, l.SetDriverSource("daemonset", DriverNodeMarker{Label: "example.com/driver"}))
+}
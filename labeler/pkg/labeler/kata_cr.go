@@ -0,0 +1,220 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/stringutil"
+	"github.com/nvidia/nvsentinel/labeler/pkg/metrics"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// KataCRNodeNamePlaceholder is replaced with the node name in KataCRSource.Name, for
+	// per-node resources such as NodeFeature
+	KataCRNodeNamePlaceholder = "{nodeName}"
+
+	// DefaultMaxConcurrentKataDetections bounds the custom resource lookups in flight at once,
+	// e.g. while the informers replay every node during the initial sync
+	DefaultMaxConcurrentKataDetections = 10
+
+	// DefaultKataDetectionTimeout bounds a single custom resource Kata detection, including the
+	// wait for a free detection slot
+	DefaultKataDetectionTimeout = 5 * time.Second
+)
+
+// KataCRSource identifies a custom resource field that reports whether Kata is enabled, such as
+// the sandbox workloads setting of the GPU operator ClusterPolicy or a NodeFeature of the node
+type KataCRSource struct {
+	// Resource is the group, version and resource of the custom resource
+	Resource schema.GroupVersionResource
+	// Namespace of the custom resource; empty for cluster-scoped resources
+	Namespace string
+	// Name of the custom resource; may contain KataCRNodeNamePlaceholder
+	Name string
+	// FieldPath is the dot-separated path of a boolean or truthy string field, e.g. spec.sandboxWorkloads.enabled
+	FieldPath string
+}
+
+// ParseKataCRResource parses a resource in the "resource.version.group" form used by kubectl,
+// e.g. "clusterpolicies.v1.nvidia.com"
+func ParseKataCRResource(resource string) (schema.GroupVersionResource, error) {
+	gvr, _ := schema.ParseResourceArg(resource)
+	if gvr == nil || gvr.Resource == "" || gvr.Version == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid kata custom resource %q, expected resource.version.group", resource)
+	}
+
+	return *gvr, nil
+}
+
+// SetKataCRSource enables Kata detection from a custom resource in addition to node labels.
+// A node is considered Kata-enabled if either source reports it. This is off by default since
+// reading the custom resource requires extra RBAC.
+func (l *Labeler) SetKataCRSource(client dynamic.Interface, source KataCRSource) error {
+	if client == nil {
+		return fmt.Errorf("a dynamic client is required for kata custom resource detection")
+	}
+
+	if source.Resource.Resource == "" || source.Name == "" || source.FieldPath == "" {
+		return fmt.Errorf("kata custom resource detection requires a resource, name and field path")
+	}
+
+	l.dynamicClient = client
+	l.kataCRSource = &source
+
+	return nil
+}
+
+// SetMaxConcurrentKataDetections limits how many custom resource Kata detections run at once;
+// detections over the limit wait for a free slot. Non-positive values keep the default.
+func (l *Labeler) SetMaxConcurrentKataDetections(limit int) {
+	if limit > 0 {
+		l.kataDetectionSlots = make(chan struct{}, limit)
+	}
+}
+
+// SetKataDetectionTimeout bounds each custom resource Kata detection, including the wait for a
+// free detection slot. Detections that time out are handled like any other lookup failure.
+// Non-positive values keep the default.
+func (l *Labeler) SetKataDetectionTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		l.kataDetectionTimeout = timeout
+	}
+}
+
+// SetKataCRCacheTTL configures how long the custom resource detection result of a node is reused
+// before the custom resource is read again. Changes to the custom resource take up to the TTL to
+// be reflected in the kata label. Non-positive values keep the default.
+func (l *Labeler) SetKataCRCacheTTL(ttl time.Duration) {
+	if ttl > 0 {
+		l.kataCRResults.setTTL(ttl)
+	}
+}
+
+// SetKataCRMissingCacheTTL configures how long the result of a node whose custom resource was not
+// found is reused. It is capped by the cache TTL. Non-positive values keep the default.
+func (l *Labeler) SetKataCRMissingCacheTTL(ttl time.Duration) {
+	if ttl > 0 {
+		l.kataCRResults.setMissingTTL(ttl)
+	}
+}
+
+// SetKataDetectOnce enables the detect once mode, for clusters where the Kata enablement of a node
+// does not change after it booted. The custom resource detection result of a node is then kept until
+// it is invalidated instead of expiring after the cache TTL, so informer resyncs and node updates do
+// not read the custom resource again. A result is invalidated by InvalidateKataDetection, by
+// ReconcileNode, or when the node reboots or is deleted; RuntimeClass events re-detect the nodes
+// they select as in the default mode. Results of missing custom resources still expire after the
+// missing TTL, since the resource may not have been created yet.
+func (l *Labeler) SetKataDetectOnce(enabled bool) {
+	l.kataCRResults.setDetectOnce(enabled)
+}
+
+// isKataEnabledByCR returns the cached custom resource detection result of the node, reading the
+// custom resource on a cache miss. Only successful lookups are cached; a missing custom resource is
+// cached for the shorter missing TTL. Cache hits and misses are counted to measure the cache.
+func (l *Labeler) isKataEnabledByCR(ctx context.Context, nodeName string) (bool, error) {
+	if enabled, cached := l.kataCRResults.get(nodeName); cached {
+		metrics.KataCacheHits.Inc()
+		return enabled, nil
+	}
+
+	metrics.KataCacheMisses.Inc()
+
+	enabled, missing, err := l.readKataCR(ctx, nodeName)
+	if err != nil {
+		return false, err
+	}
+
+	l.kataCRResults.set(nodeName, enabled, missing)
+
+	return enabled, nil
+}
+
+// readKataCR reads the configured custom resource for the node and checks whether the
+// configured field is truthy. Missing resources or fields count as not enabled, and missing is
+// set for a missing resource; any other lookup failure is returned as an error. At most the
+// configured number of lookups run concurrently, and each is bounded by the configured detection
+// timeout.
+func (l *Labeler) readKataCR(ctx context.Context, nodeName string) (enabled, missing bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, l.kataDetectionTimeout)
+	defer cancel()
+
+	select {
+	case l.kataDetectionSlots <- struct{}{}:
+		defer func() { <-l.kataDetectionSlots }()
+	case <-ctx.Done():
+		return false, false, fmt.Errorf("waiting for a kata custom resource detection slot: %w", ctx.Err())
+	}
+
+	source := l.kataCRSource
+	name := strings.ReplaceAll(source.Name, KataCRNodeNamePlaceholder, nodeName)
+
+	resources := l.dynamicClient.Resource(source.Resource)
+
+	var resource dynamic.ResourceInterface = resources
+	if source.Namespace != "" {
+		resource = resources.Namespace(source.Namespace)
+	}
+
+	obj, err := resource.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			slog.Debug("Kata custom resource not found, caching the result with the missing TTL",
+				"node", nodeName,
+				"resource", source.Resource.String(),
+				"name", name,
+			)
+
+			return false, true, nil
+		}
+
+		return false, false, fmt.Errorf("failed to get kata custom resource %s %q: %w", source.Resource.String(), name, err)
+	}
+
+	value, found, err := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(source.FieldPath, ".")...)
+	if err != nil || !found {
+		return false, false, nil
+	}
+
+	switch v := value.(type) {
+	case bool:
+		enabled = v
+	case string:
+		enabled = stringutil.IsTruthyValue(v)
+	}
+
+	if enabled {
+		slog.Debug("Kata detected",
+			"source", "customResource",
+			"node", nodeName,
+			"resource", source.Resource.String(),
+			"name", name,
+			"fieldPath", source.FieldPath,
+		)
+	}
+
+	return enabled, false, nil
+}
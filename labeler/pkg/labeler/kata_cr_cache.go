@@ -0,0 +1,210 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultKataCRCacheTTL is how long a custom resource Kata detection result is reused for a node
+	// before the custom resource is read again
+	DefaultKataCRCacheTTL = 15 * time.Minute
+
+	// DefaultKataCRMissingCacheTTL is how long the result of a node whose custom resource was not
+	// found is reused. A freshly started API server or a lagging watch cache may not serve a resource
+	// that exists yet, so such low-confidence results are read again sooner.
+	DefaultKataCRMissingCacheTTL = time.Minute
+)
+
+// kataCRCache remembers the custom resource Kata detection result of each node, so that repeated
+// events for a node within the TTL (informer resyncs, label updates) do not hit the API server
+// again. Only successful lookups are cached; entries of deleted nodes are evicted.
+type kataCRCache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	// missingTTL replaces ttl for low-confidence results of missing custom resources
+	missingTTL time.Duration
+	// detectOnce keeps results other than low-confidence ones until they are invalidated
+	detectOnce bool
+	entries    map[string]kataCRCacheEntry
+	// lastHits records whether the last lookup of each node was served from the cache
+	lastHits map[string]bool
+}
+
+type kataCRCacheEntry struct {
+	enabled       bool
+	lowConfidence bool
+	cachedAt      time.Time
+	// expiresAt is zero for results kept until they are invalidated
+	expiresAt time.Time
+}
+
+// expired returns true if the entry expired at the given time
+func (e kataCRCacheEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+func newKataCRCache(ttl time.Duration) *kataCRCache {
+	return &kataCRCache{
+		ttl:        ttl,
+		missingTTL: DefaultKataCRMissingCacheTTL,
+		entries:    make(map[string]kataCRCacheEntry),
+		lastHits:   make(map[string]bool),
+	}
+}
+
+// get returns the cached result of the node if it has not expired, and records whether the lookup
+// was a hit
+func (c *kataCRCache) get(nodeName string) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[nodeName]
+	if exists && entry.expired(time.Now()) {
+		delete(c.entries, nodeName)
+
+		exists = false
+	}
+
+	c.lastHits[nodeName] = exists
+
+	return entry.enabled && exists, exists
+}
+
+// lastWasHit returns true if the last lookup of the node was served from the cache
+func (c *kataCRCache) lastWasHit(nodeName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastHits[nodeName]
+}
+
+// set caches the result of the node for the TTL, or for the shorter of the TTL and the missing TTL
+// if the result has low confidence. In detect once mode results other than low-confidence ones do
+// not expire.
+func (c *kataCRCache) set(nodeName string, enabled, lowConfidence bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ttl := c.ttl
+	if lowConfidence {
+		ttl = min(ttl, c.missingTTL)
+	}
+
+	now := time.Now()
+	entry := kataCRCacheEntry{
+		enabled:       enabled,
+		lowConfidence: lowConfidence,
+		cachedAt:      now,
+		expiresAt:     now.Add(ttl),
+	}
+
+	if c.detectOnce && !lowConfidence {
+		entry.expiresAt = time.Time{}
+	}
+
+	c.entries[nodeName] = entry
+}
+
+// setTTL changes the TTL and drops the cached results
+func (c *kataCRCache) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = ttl
+	c.entries = make(map[string]kataCRCacheEntry)
+	c.lastHits = make(map[string]bool)
+}
+
+// setMissingTTL changes the TTL of low-confidence results and drops the cached results
+func (c *kataCRCache) setMissingTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.missingTTL = ttl
+	c.entries = make(map[string]kataCRCacheEntry)
+	c.lastHits = make(map[string]bool)
+}
+
+// setDetectOnce changes whether results are kept until invalidated and drops the cached results
+func (c *kataCRCache) setDetectOnce(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.detectOnce = enabled
+	c.entries = make(map[string]kataCRCacheEntry)
+	c.lastHits = make(map[string]bool)
+}
+
+// forgetAll drops the cached results of every node
+func (c *kataCRCache) forgetAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]kataCRCacheEntry)
+	c.lastHits = make(map[string]bool)
+}
+
+// forget drops the cached result of a deleted node
+func (c *kataCRCache) forget(nodeName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, nodeName)
+	delete(c.lastHits, nodeName)
+}
+
+// size returns the number of cached nodes
+func (c *kataCRCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// dump returns a copy of the unexpired entries, ordered by node name
+func (c *kataCRCache) dump(now time.Time) KataCRCacheDump {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	dump := KataCRCacheDump{TTL: c.ttl.String(), MissingTTL: c.missingTTL.String(), Entries: []KataCRCacheEntryDump{}}
+
+	for nodeName, entry := range c.entries {
+		if entry.expired(now) {
+			continue
+		}
+
+		expiresIn := "never"
+		if !entry.expiresAt.IsZero() {
+			expiresIn = entry.expiresAt.Sub(now).Round(time.Second).String()
+		}
+
+		dump.Entries = append(dump.Entries, KataCRCacheEntryDump{
+			Node:          nodeName,
+			Enabled:       entry.enabled,
+			LowConfidence: entry.lowConfidence,
+			LastLookupHit: c.lastHits[nodeName],
+			Age:           now.Sub(entry.cachedAt).Round(time.Second).String(),
+			ExpiresIn:     expiresIn,
+		})
+	}
+
+	sort.Slice(dump.Entries, func(i, j int) bool { return dump.Entries[i].Node < dump.Entries[j].Node })
+
+	return dump
+}
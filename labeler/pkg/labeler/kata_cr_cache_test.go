@@ -0,0 +1,456 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nvidia/nvsentinel/labeler/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestKataCRCache(t *testing.T) {
+	c := newKataCRCache(time.Minute)
+
+	_, cached := c.get("node-1")
+	assert.False(t, cached)
+
+	c.set("node-1", true, false)
+	enabled, cached := c.get("node-1")
+	assert.True(t, cached)
+	assert.True(t, enabled)
+
+	c.forget("node-1")
+	_, cached = c.get("node-1")
+	assert.False(t, cached)
+
+	c.setTTL(time.Nanosecond)
+	c.set("node-1", true, false)
+	time.Sleep(time.Millisecond)
+
+	_, cached = c.get("node-1")
+	assert.False(t, cached, "expired entries are not returned")
+	assert.Equal(t, 0, c.size())
+}
+
+func TestKataCRCache_LastWasHit(t *testing.T) {
+	c := newKataCRCache(time.Minute)
+
+	assert.False(t, c.lastWasHit("node-1"), "a node never looked up was not a hit")
+
+	_, cached := c.get("node-1")
+	require.False(t, cached)
+	assert.False(t, c.lastWasHit("node-1"), "the first lookup is a miss")
+
+	c.set("node-1", true, false)
+
+	_, cached = c.get("node-1")
+	require.True(t, cached)
+	assert.True(t, c.lastWasHit("node-1"), "the second lookup is a hit")
+	assert.False(t, c.lastWasHit("node-2"), "hits are tracked per node")
+
+	c.setTTL(time.Nanosecond)
+	c.set("node-1", true, false)
+	time.Sleep(time.Millisecond)
+
+	_, cached = c.get("node-1")
+	require.False(t, cached)
+	assert.False(t, c.lastWasHit("node-1"), "a lookup after expiry is a miss")
+}
+
+func TestKataCRDetection_CachedAcrossEvents(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+			map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}))
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	for range 3 {
+		detection, err := l.detectKata(context.Background(), node)
+		require.NoError(t, err)
+		assert.True(t, detection.IsKata)
+	}
+
+	assert.Len(t, dynamicClient.Actions(), 1, "events within the TTL reuse the cached result")
+
+	// Deleting the node evicts its entry, so a re-registered node is read again
+	l.handleNodeDeleteEvent(cache.DeletedFinalStateUnknown{Key: node.Name, Obj: node})
+	assert.Equal(t, 0, l.kataCRResults.size())
+
+	_, err = l.detectKata(context.Background(), node)
+	require.NoError(t, err)
+	assert.Len(t, dynamicClient.Actions(), 2)
+}
+
+func TestKataCRDetection_DetectOnce(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	require.NoError(t, l.nodeInformer.GetIndexer().Add(node))
+
+	policy := newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+		map[string]any{"sandboxWorkloads": map[string]any{"enabled": false}})
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), policy)
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetKataCRCacheTTL(time.Millisecond)
+	l.SetKataDetectOnce(true)
+
+	require.NoError(t, l.handleNodeEvent(node))
+
+	policy.Object["spec"] = map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}
+	_, err = dynamicClient.Resource(clusterPolicyGVR).Update(ctx, policy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	dynamicClient.ClearActions()
+	time.Sleep(5 * time.Millisecond)
+
+	// The result outlives the TTL
+	for range 3 {
+		require.NoError(t, l.handleNodeEvent(node))
+	}
+
+	assert.Empty(t, dynamicClient.Actions(), "node events do not read the custom resource until invalidated")
+
+	dump := l.kataCRResults.dump(time.Now())
+	require.Len(t, dump.Entries, 1)
+	assert.Equal(t, "never", dump.Entries[0].ExpiresIn)
+
+	updated, err := clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, LabelValueFalse, updated.Labels[KataEnabledLabel])
+
+	require.NoError(t, l.InvalidateKataDetection(node.Name))
+	assert.Len(t, dynamicClient.Actions(), 1)
+
+	updated, err = clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+
+	// Invalidating every node reads the custom resource again as well
+	require.NoError(t, l.InvalidateKataDetection(""))
+	assert.Len(t, dynamicClient.Actions(), 2)
+}
+
+func TestKataCRDetection_DetectOnceKeepsMissingTTL(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetKataCRMissingCacheTTL(time.Millisecond)
+	l.SetKataDetectOnce(true)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	_, err = l.detectKata(context.Background(), node)
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = l.detectKata(context.Background(), node)
+	require.NoError(t, err)
+
+	assert.Len(t, dynamicClient.Actions(), 2, "a missing custom resource is read again after the missing TTL")
+}
+
+func TestKataCRDetection_CacheHitsAndMisses(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+			map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}))
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	hits := testutil.ToFloat64(metrics.KataCacheHits)
+	misses := testutil.ToFloat64(metrics.KataCacheMisses)
+
+	for range 3 {
+		_, err := l.detectKata(context.Background(), node)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, hits+2, testutil.ToFloat64(metrics.KataCacheHits), "detections within the TTL are hits")
+	assert.Equal(t, misses+1, testutil.ToFloat64(metrics.KataCacheMisses), "the first detection is a miss")
+
+	// Once the TTL expires the custom resource is read again
+	l.SetKataCRCacheTTL(time.Nanosecond)
+
+	for range 2 {
+		time.Sleep(time.Millisecond)
+
+		_, err := l.detectKata(context.Background(), node)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, hits+2, testutil.ToFloat64(metrics.KataCacheHits))
+	assert.Equal(t, misses+3, testutil.ToFloat64(metrics.KataCacheMisses), "detections after the TTL are misses")
+}
+
+func TestReconcileNode_InvalidatesCachedKataCRResult(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	policy := newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+		map[string]any{"sandboxWorkloads": map[string]any{"enabled": false}})
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), policy)
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	require.NoError(t, l.handleNodeEvent(node))
+
+	// Kata is enabled after the first detection; node events keep the cached result
+	policy.Object["spec"] = map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}
+	_, err = dynamicClient.Resource(clusterPolicyGVR).Update(ctx, policy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	dynamicClient.ClearActions()
+
+	updated, err := clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NoError(t, l.handleNodeEvent(updated))
+
+	assert.Empty(t, dynamicClient.Actions(), "node events within the TTL do not read the custom resource")
+
+	updated, err = clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, LabelValueFalse, updated.Labels[KataEnabledLabel])
+
+	// An explicit reconcile re-reads it
+	require.NoError(t, l.ReconcileNode(ctx, node.Name))
+	assert.Len(t, dynamicClient.Actions(), 1)
+
+	updated, err = clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+}
+
+func TestNodeUpdateEvent_RebootInvalidatesCachedKataCRResult(t *testing.T) {
+	readyNode := func(bootID string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{
+				NodeInfo:   corev1.NodeSystemInfo{BootID: bootID},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		oldNode  *corev1.Node
+		newNode  *corev1.Node
+		redetect bool
+	}{
+		{
+			name:     "boot ID changed",
+			oldNode:  readyNode("boot-1", corev1.ConditionTrue),
+			newNode:  readyNode("boot-2", corev1.ConditionTrue),
+			redetect: true,
+		},
+		{
+			name:     "node became ready",
+			oldNode:  readyNode("boot-1", corev1.ConditionUnknown),
+			newNode:  readyNode("boot-1", corev1.ConditionTrue),
+			redetect: true,
+		},
+		{
+			name:    "node stayed ready",
+			oldNode: readyNode("boot-1", corev1.ConditionTrue),
+			newNode: readyNode("boot-1", corev1.ConditionTrue),
+		},
+		{
+			name:    "node became not ready",
+			oldNode: readyNode("boot-1", corev1.ConditionTrue),
+			newNode: readyNode("boot-1", corev1.ConditionFalse),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			clientset := fake.NewSimpleClientset(tt.oldNode)
+
+			l, err := NewLabeler(clientset, time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+
+			l.ctx = ctx
+
+			policy := newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+				map[string]any{"sandboxWorkloads": map[string]any{"enabled": false}})
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), policy)
+			require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			}))
+
+			require.NoError(t, l.handleNodeEvent(tt.oldNode))
+
+			// The operator re-applies Kata while the node reboots
+			policy.Object["spec"] = map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}
+			_, err = dynamicClient.Resource(clusterPolicyGVR).Update(ctx, policy, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			dynamicClient.ClearActions()
+
+			require.NoError(t, l.handleNodeUpdateEvent(tt.oldNode, tt.newNode))
+
+			updated, err := clientset.CoreV1().Nodes().Get(ctx, "node-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			if tt.redetect {
+				assert.Len(t, dynamicClient.Actions(), 1, "the custom resource is read again")
+				assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+			} else {
+				assert.Empty(t, dynamicClient.Actions(), "the cached result is reused")
+				assert.Equal(t, LabelValueFalse, updated.Labels[KataEnabledLabel])
+			}
+		})
+	}
+}
+
+func TestKataCRDetection_FailuresAreNotCached(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.SetKataCRSource(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetMaxConcurrentKataDetections(1)
+	l.SetKataDetectionTimeout(10 * time.Millisecond)
+
+	l.kataDetectionSlots <- struct{}{}
+
+	_, err = l.isKataEnabledByCR(context.Background(), "node-1")
+	require.Error(t, err)
+	assert.Equal(t, 0, l.kataCRResults.size())
+}
+
+func TestSetKataCRCacheTTL(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultKataCRCacheTTL, l.kataCRResults.ttl)
+
+	l.SetKataCRCacheTTL(-time.Second)
+	assert.Equal(t, DefaultKataCRCacheTTL, l.kataCRResults.ttl, "non-positive TTL keeps the default")
+
+	l.SetKataCRCacheTTL(time.Minute)
+	assert.Equal(t, time.Minute, l.kataCRResults.ttl)
+}
+
+func TestKataCRDetection_MissingResourceCachedWithShortTTL(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	// Only node-2 has its per-node custom resource, e.g. because the watch cache lags for node-1
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "node-2",
+			map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}))
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      KataCRNodeNamePlaceholder,
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	for _, name := range []string{"node-1", "node-2"} {
+		_, err := l.detectKata(context.Background(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		require.NoError(t, err)
+	}
+
+	dump := l.kataCRResults.dump(time.Now())
+	require.Len(t, dump.Entries, 2)
+	assert.Equal(t, KataCRCacheEntryDump{Node: "node-1", LowConfidence: true, Age: "0s", ExpiresIn: "1m0s"},
+		dump.Entries[0])
+	assert.Equal(t, KataCRCacheEntryDump{Node: "node-2", Enabled: true, Age: "0s", ExpiresIn: "15m0s"},
+		dump.Entries[1])
+
+	// The missing resource is read again once the short TTL expires
+	dynamicClient.ClearActions()
+
+	_, err = l.detectKata(context.Background(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	require.NoError(t, err)
+	assert.Empty(t, dynamicClient.Actions())
+
+	_, cached := l.kataCRResults.get("node-1")
+	require.True(t, cached)
+
+	l.SetKataCRMissingCacheTTL(time.Nanosecond)
+	l.kataCRResults.set("node-1", false, true)
+	time.Sleep(time.Millisecond)
+
+	_, err = l.detectKata(context.Background(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	require.NoError(t, err)
+	assert.Len(t, dynamicClient.Actions(), 1)
+}
+
+func TestKataCRCache_MissingTTLIsCappedByTTL(t *testing.T) {
+	c := newKataCRCache(30 * time.Second)
+	c.set("node-1", false, true)
+
+	dump := c.dump(time.Now())
+	require.Len(t, dump.Entries, 1)
+	assert.Equal(t, "30s", dump.Entries[0].ExpiresIn)
+}
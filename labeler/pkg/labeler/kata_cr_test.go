@@ -0,0 +1,400 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	clusterPolicyGVR = schema.GroupVersionResource{Group: "nvidia.com", Version: "v1", Resource: "clusterpolicies"}
+	nodeFeatureGVR   = schema.GroupVersionResource{Group: "nfd.k8s-sigs.io", Version: "v1alpha1", Resource: "nodefeatures"}
+)
+
+func newKataCR(gvr schema.GroupVersionResource, kind, namespace, name string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetAPIVersion(gvr.GroupVersion().String())
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+func TestKataCRDetection(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   KataCRSource
+		objects  []runtime.Object
+		node     *corev1.Node
+		expected string
+	}{
+		{
+			name: "cluster policy enables kata",
+			source: KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			},
+			objects: []runtime.Object{
+				newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+					map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}),
+			},
+			expected: LabelValueTrue,
+		},
+		{
+			name: "cluster policy disables kata",
+			source: KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			},
+			objects: []runtime.Object{
+				newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+					map[string]any{"sandboxWorkloads": map[string]any{"enabled": false}}),
+			},
+			expected: LabelValueFalse,
+		},
+		{
+			name: "per-node feature with truthy string enables kata",
+			source: KataCRSource{
+				Resource:  nodeFeatureGVR,
+				Namespace: "node-feature-discovery",
+				Name:      "nfd-" + KataCRNodeNamePlaceholder,
+				FieldPath: "spec.labels.kata",
+			},
+			objects: []runtime.Object{
+				newKataCR(nodeFeatureGVR, "NodeFeature", "node-feature-discovery", "nfd-node-1",
+					map[string]any{"labels": map[string]any{"kata": "enabled"}}),
+			},
+			expected: LabelValueTrue,
+		},
+		{
+			name: "per-node feature of another node is ignored",
+			source: KataCRSource{
+				Resource:  nodeFeatureGVR,
+				Namespace: "node-feature-discovery",
+				Name:      "nfd-" + KataCRNodeNamePlaceholder,
+				FieldPath: "spec.labels.kata",
+			},
+			objects: []runtime.Object{
+				newKataCR(nodeFeatureGVR, "NodeFeature", "node-feature-discovery", "nfd-node-2",
+					map[string]any{"labels": map[string]any{"kata": "true"}}),
+			},
+			expected: LabelValueFalse,
+		},
+		{
+			name: "missing field path",
+			source: KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			},
+			objects: []runtime.Object{
+				newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy", map[string]any{}),
+			},
+			expected: LabelValueFalse,
+		},
+		{
+			name: "node label enables kata when the custom resource does not",
+			source: KataCRSource{
+				Resource:  clusterPolicyGVR,
+				Name:      "cluster-policy",
+				FieldPath: "spec.sandboxWorkloads.enabled",
+			},
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{KataRuntimeDefaultLabel: "true"},
+			}},
+			expected: LabelValueTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), tt.objects...)
+			require.NoError(t, l.SetKataCRSource(dynamicClient, tt.source))
+
+			node := tt.node
+			if node == nil {
+				node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+			}
+
+			detection, err := l.detectKata(context.Background(), node)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detection.labelValue())
+		})
+	}
+}
+
+func TestKataCRDetection_BoundedConcurrency(t *testing.T) {
+	const (
+		limit = 3
+		nodes = 20
+	)
+
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	var inFlight, maxInFlight atomic.Int32
+
+	dynamicClient.PrependReactor("get", "clusterpolicies",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(20 * time.Millisecond)
+
+			return true, newKataCR(clusterPolicyGVR, "ClusterPolicy", "", "cluster-policy",
+				map[string]any{"sandboxWorkloads": map[string]any{"enabled": true}}), nil
+		})
+
+	require.NoError(t, l.SetKataCRSource(dynamicClient, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetMaxConcurrentKataDetections(limit)
+
+	var wg sync.WaitGroup
+
+	for i := range nodes {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("node-%d", i)}}
+			detection, err := l.detectKata(context.Background(), node)
+			assert.NoError(t, err)
+			assert.True(t, detection.IsKata)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(limit))
+	assert.Positive(t, maxInFlight.Load())
+}
+
+func TestKataCRDetection_WaitingDetectionHonorsContext(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.SetKataCRSource(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetMaxConcurrentKataDetections(1)
+
+	// Occupy the only slot so the detection has to wait
+	l.kataDetectionSlots <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	enabled, err := l.isKataEnabledByCR(ctx, "node-1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, enabled)
+}
+
+func TestKataCRDetection_Timeout(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultKataDetectionTimeout, l.kataDetectionTimeout)
+
+	require.NoError(t, l.SetKataCRSource(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	l.SetMaxConcurrentKataDetections(1)
+
+	l.SetKataDetectionTimeout(0)
+	assert.Equal(t, DefaultKataDetectionTimeout, l.kataDetectionTimeout, "non-positive timeout keeps the default")
+
+	l.SetKataDetectionTimeout(50 * time.Millisecond)
+	assert.Equal(t, 50*time.Millisecond, l.kataDetectionTimeout)
+
+	// Occupy the only slot; the configured timeout bounds the wait even without a caller deadline
+	l.kataDetectionSlots <- struct{}{}
+
+	start := time.Now()
+	detection, err := l.detectKata(context.Background(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, detection.IsKata)
+	assert.Less(t, time.Since(start), DefaultKataDetectionTimeout)
+}
+
+func TestKataCRDetection_ResultOnTimeout(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-1",
+		Labels: map[string]string{"runtime.gvisor.dev/enabled": "true"},
+	}}
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.SetKataCRSource(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+	require.NoError(t, l.SetRuntimeFeatures([]RuntimeFeature{{Name: "gvisor", Labels: []string{"runtime.gvisor.dev/enabled"}}}))
+	l.SetMaxConcurrentKataDetections(1)
+	l.SetKataDetectionTimeout(50 * time.Millisecond)
+
+	// Occupy the only slot so the detection times out
+	l.kataDetectionSlots <- struct{}{}
+
+	detection, err := l.detectKata(context.Background(), node)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, DetectionResult{}, detection, "only the error is returned by default")
+
+	l.SetKataDetectionResultOnTimeout(true)
+
+	detection, err = l.detectKata(context.Background(), node)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, DetectionResult{
+		IsKata:   false,
+		Method:   DetectionMethodNone,
+		Features: map[string]bool{"gvisor": true},
+		Timeout:  true,
+	}, detection)
+
+	// The runtime feature labels are written while the kata label is left alone
+	assert.Error(t, l.handleKataDetectionError(context.Background(), node, detection, err))
+
+	labels := getTestNode(t, clientset, node.Name).Labels
+	assert.Equal(t, LabelValueTrue, labels[RuntimeFeatureLabel("gvisor")])
+	assert.NotContains(t, labels, KataEnabledLabel)
+}
+
+type requestIDKey struct{}
+
+// ctxRecordingDynamicClient records the context of every custom resource Get
+type ctxRecordingDynamicClient struct {
+	dynamic.Interface
+	record func(ctx context.Context)
+}
+
+func (c ctxRecordingDynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return ctxRecordingResource{NamespaceableResourceInterface: c.Interface.Resource(gvr), record: c.record}
+}
+
+type ctxRecordingResource struct {
+	dynamic.NamespaceableResourceInterface
+	record func(ctx context.Context)
+}
+
+func (r ctxRecordingResource) Get(ctx context.Context, name string, options metav1.GetOptions,
+	subresources ...string) (*unstructured.Unstructured, error) {
+	r.record(ctx)
+	return r.NamespaceableResourceInterface.Get(ctx, name, options, subresources...)
+}
+
+func TestKataCRDetection_PropagatesCallerContextValues(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	l, err := NewLabeler(fake.NewSimpleClientset(node), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	var seen []any
+
+	require.NoError(t, l.SetKataCRSource(ctxRecordingDynamicClient{
+		Interface: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		record: func(ctx context.Context) {
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline, "lookups are bounded by the detection timeout")
+
+			seen = append(seen, ctx.Value(requestIDKey{}))
+		},
+	}, KataCRSource{
+		Resource:  clusterPolicyGVR,
+		Name:      "cluster-policy",
+		FieldPath: "spec.sandboxWorkloads.enabled",
+	}))
+
+	// Node events detect with the context Run was started with
+	l.ctx = context.WithValue(context.Background(), requestIDKey{}, "run")
+	require.NoError(t, l.handleNodeEvent(node))
+
+	// On-demand reconciles detect with the caller's context
+	require.NoError(t, l.ReconcileNode(context.WithValue(context.Background(), requestIDKey{}, "reconcile"), node.Name))
+
+	assert.Equal(t, []any{"run", "reconcile"}, seen)
+}
+
+func TestSetKataCRSource_Validation(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	assert.Error(t, l.SetKataCRSource(nil, KataCRSource{Resource: clusterPolicyGVR, Name: "cp", FieldPath: "spec.x"}))
+	assert.Error(t, l.SetKataCRSource(dynamicClient, KataCRSource{Resource: clusterPolicyGVR, FieldPath: "spec.x"}))
+	assert.Error(t, l.SetKataCRSource(dynamicClient, KataCRSource{Resource: clusterPolicyGVR, Name: "cp"}))
+	assert.Nil(t, l.kataCRSource)
+}
+
+func TestParseKataCRResource(t *testing.T) {
+	gvr, err := ParseKataCRResource("clusterpolicies.v1.nvidia.com")
+	require.NoError(t, err)
+	assert.Equal(t, clusterPolicyGVR, gvr)
+
+	gvr, err = ParseKataCRResource("nodefeatures.v1alpha1.nfd.k8s-sigs.io")
+	require.NoError(t, err)
+	assert.Equal(t, nodeFeatureGVR, gvr)
+
+	_, err = ParseKataCRResource("clusterpolicies")
+	assert.Error(t, err)
+}
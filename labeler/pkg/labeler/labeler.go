@@ -16,20 +16,28 @@ package labeler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/nvidia/nvsentinel/commons/pkg/stringutil"
 	"github.com/nvidia/nvsentinel/labeler/pkg/metrics"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	nodelisters "k8s.io/client-go/listers/node/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
 )
@@ -46,6 +54,19 @@ const (
 	// Label values
 	LabelValueTrue  = "true"
 	LabelValueFalse = "false"
+
+	// DefaultResyncPeriod is applied when NewLabeler is given a zero resync period
+	DefaultResyncPeriod = 30 * time.Second
+	// MinRecommendedResyncPeriod is the threshold below which a warning is logged, since
+	// every resync replays update events for all watched pods and nodes and reconciles every node
+	MinRecommendedResyncPeriod = 10 * time.Second
+
+	// DefaultCacheSyncAttempts is the number of cache sync attempts Run makes before giving up
+	DefaultCacheSyncAttempts = 5
+	// DefaultCacheSyncTimeout bounds the first cache sync attempt; each retry doubles it
+	DefaultCacheSyncTimeout = 30 * time.Second
+	// maxCacheSyncTimeout caps the per-attempt timeout as it doubles
+	maxCacheSyncTimeout = 5 * time.Minute
 )
 
 var (
@@ -58,22 +79,110 @@ type Labeler struct {
 	clientset       kubernetes.Interface
 	podInformer     cache.SharedIndexInformer
 	nodeInformer    cache.SharedIndexInformer
+	podIndex        PodIndex
 	informersSynced []cache.InformerSynced
 	ctx             context.Context
-	dcgmAppLabel    string
-	driverAppLabel  string
+	dcgmAppLabels   []string
+	driverAppLabels []string
 	kataLabels      []string // Instance-specific kata labels
+	nodeLocks       *nodeLocks
+	resyncPeriod    time.Duration
+
+	cacheSyncAttempts int
+	cacheSyncTimeout  time.Duration
+
+	// dynamicClient and kataCRSource are set when Kata detection from a custom resource is enabled
+	dynamicClient dynamic.Interface
+	kataCRSource  *KataCRSource
+	// kataDetectionSlots is a semaphore bounding concurrent custom resource lookups
+	kataDetectionSlots chan struct{}
+	// kataDetectionTimeout bounds a single custom resource lookup
+	kataDetectionTimeout time.Duration
+	// kataCRResults caches custom resource lookups per node
+	kataCRResults *kataCRCache
+	// resultOnTimeout returns a result with Timeout set alongside a timed out detection error
+	resultOnTimeout bool
+	// kataExtendedResource detects Kata from a node extended resource when set
+	kataExtendedResource v1.ResourceName
+	// requireLabelCorroboration only trusts a kata label that another detection method confirms
+	requireLabelCorroboration bool
+
+	// kataObserveOnly runs Kata detection without ever writing the kata label
+	kataObserveOnly bool
+	// runtimeClassInformer and runtimeClasses are set when Kata detection from RuntimeClasses is enabled
+	runtimeClassInformer cache.SharedIndexInformer
+	runtimeClasses       nodelisters.RuntimeClassLister
+	// kataSelectors caches the node selectors of the Kata RuntimeClasses until a RuntimeClass changes
+	kataSelectors kataSelectorCache
+	// kataDefaultLabel is written when detection fails for a node that was never detected
+	kataDefaultLabel string
+	// runtimeFeatures are detected alongside Kata and written to one label each
+	runtimeFeatures []RuntimeFeature
+	// falseLabelMode selects whether negative detection results set "false" or remove the label
+	falseLabelMode string
+
+	// driverSource and driverNodeMarker select where the driver.installed label is derived from
+	driverSource     string
+	driverNodeMarker DriverNodeMarker
+
+	// maintenance identifies nodes whose ready-implying labels are suppressed
+	maintenance MaintenanceMarker
+
+	// operatorGate defers labeling until the GPU operator DaemonSets exist
+	operatorGate             *OperatorGate
+	operatorGatePollInterval time.Duration
+
+	// detectionMetrics records the Kata detection metrics, to Prometheus unless replaced
+	detectionMetrics DetectionMetricsRecorder
+
+	// detections tracks the last Kata detection result per node to emit Events on changes
+	detections  *detectionTracker
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+
+	// closed is closed by Close to stop Run and any in-flight detection
+	closed    chan struct{}
+	closeOnce sync.Once
 }
 
-// NewLabeler creates a new Labeler instance
+// NewLabeler creates a new Labeler instance.
+//
+// resyncPeriod is the informer resync interval. On every resync the informers replay an
+// update event for each cached pod and node. The replayed node events periodically re-drive
+// label reconciliation (a full sweep of all nodes, recomputing the DCGM and driver labels from
+// the pod indexers) even when no pod or node changed; the replayed pod events are dropped like
+// any other pod update that leaves the readiness unchanged. A zero value
+// applies DefaultResyncPeriod rather than disabling resync, a negative value is rejected, and
+// values below MinRecommendedResyncPeriod are accepted with a warning because they can cause
+// event storms against the API server in large clusters.
+//
+// dcgmApps and driverApps list the "app" label values of the DCGM and driver pods. Clusters may
+// run more than one driver DaemonSet (e.g. the GPU operator driver and a precompiled vendor
+// driver); a node is labeled as having a driver installed if a ready pod of any of them runs on it.
 // nolint: cyclop // todo
 func NewLabeler(clientset kubernetes.Interface, resyncPeriod time.Duration,
-	dcgmApp, driverApp, kataLabelOverride string) (*Labeler, error) {
-	labelSelector, err := labels.Parse(fmt.Sprintf("app in (%s,%s)", dcgmApp, driverApp))
+	dcgmApps, driverApps []string, kataLabelOverride string) (*Labeler, error) {
+	resyncPeriod, err := validateResyncPeriod(resyncPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dcgmApps) == 0 || len(driverApps) == 0 {
+		return nil, fmt.Errorf("at least one DCGM and one driver app label are required")
+	}
+
+	apps := append(append([]string{}, dcgmApps...), driverApps...)
+
+	labelSelector, err := labels.Parse(fmt.Sprintf("app in (%s)", strings.Join(apps, ",")))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse label selector: %w", err)
 	}
 
+	kataLabelOverride, err = normalizeKataLabelOverride(kataLabelOverride)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build kata labels list with default plus optional override
 	kataLabels := []string{KataRuntimeDefaultLabel}
 	if kataLabelOverride != "" {
@@ -103,7 +212,7 @@ func NewLabeler(clientset kubernetes.Interface, resyncPeriod time.Duration,
 					return nil, fmt.Errorf("object is not a pod")
 				}
 
-				if app, exists := pod.Labels["app"]; exists && app == dcgmApp {
+				if app, exists := pod.Labels["app"]; exists && slices.Contains(dcgmApps, app) {
 					return []string{pod.Spec.NodeName}, nil
 				}
 
@@ -115,7 +224,7 @@ func NewLabeler(clientset kubernetes.Interface, resyncPeriod time.Duration,
 					return nil, fmt.Errorf("object is not a pod")
 				}
 
-				if app, exists := pod.Labels["app"]; exists && app == driverApp {
+				if app, exists := pod.Labels["app"]; exists && slices.Contains(driverApps, app) {
 					return []string{pod.Spec.NodeName}, nil
 				}
 
@@ -126,15 +235,39 @@ func NewLabeler(clientset kubernetes.Interface, resyncPeriod time.Duration,
 		return nil, fmt.Errorf("failed to add indexer: %w", err)
 	}
 
+	broadcaster, recorder := newEventRecorder()
+
 	l := &Labeler{
 		clientset:       clientset,
 		podInformer:     podInformer,
 		nodeInformer:    nodeInformer,
+		podIndex:        podInformer.GetIndexer(),
 		informersSynced: []cache.InformerSynced{podInformer.HasSynced, nodeInformer.HasSynced},
 		ctx:             context.Background(),
-		dcgmAppLabel:    dcgmApp,
-		driverAppLabel:  driverApp,
+		dcgmAppLabels:   dcgmApps,
+		driverAppLabels: driverApps,
 		kataLabels:      kataLabels,
+		nodeLocks:       newNodeLocks(),
+		resyncPeriod:    resyncPeriod,
+
+		cacheSyncAttempts: DefaultCacheSyncAttempts,
+		cacheSyncTimeout:  DefaultCacheSyncTimeout,
+
+		kataDetectionSlots:   make(chan struct{}, DefaultMaxConcurrentKataDetections),
+		kataDetectionTimeout: DefaultKataDetectionTimeout,
+		kataCRResults:        newKataCRCache(DefaultKataCRCacheTTL),
+		falseLabelMode:       DetectionFalseLabelSet,
+		driverSource:         DriverSourcePod,
+
+		operatorGatePollInterval: DefaultOperatorGatePollInterval,
+
+		detectionMetrics: prometheusDetectionRecorder{},
+
+		detections:  newDetectionTracker(),
+		broadcaster: broadcaster,
+		recorder:    recorder,
+
+		closed: make(chan struct{}),
 	}
 
 	// Register event handlers
@@ -146,11 +279,46 @@ func NewLabeler(clientset kubernetes.Interface, resyncPeriod time.Duration,
 		return nil, err
 	}
 
-	slog.Info("Labeler created, watching DCGM and driver pods, and nodes for kata detection")
+	slog.Info("Labeler created, watching DCGM and driver pods, and nodes for label reconciliation and kata detection")
 
 	return l, nil
 }
 
+// validateResyncPeriod applies the default for a zero resync period, rejects negative values,
+// and warns when the period is small enough to cause resync storms. The sweep cost is in the
+// node events, each of which reconciles the labels of its node; pod events without a readiness
+// change are dropped.
+func validateResyncPeriod(resyncPeriod time.Duration) (time.Duration, error) {
+	switch {
+	case resyncPeriod < 0:
+		return 0, fmt.Errorf("invalid resync period %s: must not be negative", resyncPeriod)
+	case resyncPeriod == 0:
+		slog.Info("No resync period configured, using default", "resyncPeriod", DefaultResyncPeriod)
+		return DefaultResyncPeriod, nil
+	case resyncPeriod < MinRecommendedResyncPeriod:
+		slog.Warn("Resync period is below the recommended minimum and may cause event storms",
+			"resyncPeriod", resyncPeriod,
+			"recommendedMinimum", MinRecommendedResyncPeriod)
+	}
+
+	return resyncPeriod, nil
+}
+
+// normalizeKataLabelOverride trims the kata label override and validates it as a label key, since
+// a malformed key would silently never match any node label
+func normalizeKataLabelOverride(override string) (string, error) {
+	override = strings.TrimSpace(override)
+	if override == "" {
+		return "", nil
+	}
+
+	if errs := validation.IsQualifiedName(override); len(errs) > 0 {
+		return "", fmt.Errorf("invalid kata label override %q: %s", override, strings.Join(errs, "; "))
+	}
+
+	return override, nil
+}
+
 // registerPodEventHandlers sets up event handlers for pod informer
 func (l *Labeler) registerPodEventHandlers() error {
 	_, err := l.podInformer.AddEventHandler(cache.FilteringResourceEventHandler{
@@ -221,10 +389,11 @@ func (l *Labeler) registerNodeEventHandlers() error {
 			}
 		},
 		UpdateFunc: func(oldObj, newObj any) {
-			if err := l.handleNodeEvent(newObj); err != nil {
+			if err := l.handleNodeUpdateEvent(oldObj, newObj); err != nil {
 				slog.Error("Failed to handle node update event", "error", err)
 			}
 		},
+		DeleteFunc: l.handleNodeDeleteEvent,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to add node event handler: %w", err)
@@ -233,20 +402,64 @@ func (l *Labeler) registerNodeEventHandlers() error {
 	return nil
 }
 
-// Run starts the labeler and waits for cache sync
+// Run starts the labeler and waits for cache sync. With an operator gate, the informers, and so
+// labeling, only start once the gate opens. Run returns once ctx is done or Close is called.
 func (l *Labeler) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-l.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := l.run(ctx)
+
+	select {
+	case <-l.closed:
+		// Errors of a closed labeler are the context cancellation of Close
+		return nil
+	default:
+		return err
+	}
+}
+
+// Close stops Run, cancels in-flight detection and releases the informers and the event
+// broadcaster, for callers that manage the labeler lifetime without the context passed to Run.
+// Run returns shortly after. Close is safe to call more than once, and before or without Run.
+func (l *Labeler) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		l.broadcaster.Shutdown()
+	})
+
+	return nil
+}
+
+// run starts the informers and blocks until ctx is done
+func (l *Labeler) run(ctx context.Context) error {
 	l.ctx = ctx
 
+	if err := l.waitForOperator(ctx); err != nil {
+		return err
+	}
+
+	l.broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: l.clientset.CoreV1().Events("")})
+	defer l.broadcaster.Shutdown()
+
 	go l.podInformer.Run(ctx.Done())
 	go l.nodeInformer.Run(ctx.Done())
 
-	slog.Info("Waiting for Labeler caches to sync...")
-
-	if ok := cache.WaitForCacheSync(ctx.Done(), l.informersSynced...); !ok {
-		return fmt.Errorf("failed to wait for caches to sync")
+	if l.runtimeClassInformer != nil {
+		go l.runtimeClassInformer.Run(ctx.Done())
 	}
 
-	slog.Info("Labeler caches synced")
+	if err := l.waitForCacheSync(ctx); err != nil {
+		return err
+	}
 
 	<-ctx.Done()
 	slog.Info("Labeler stopped")
@@ -254,9 +467,56 @@ func (l *Labeler) Run(ctx context.Context) error {
 	return nil
 }
 
+// SetCacheSyncRetry configures how Run waits for the informer caches to sync. Run makes up to
+// attempts sync attempts, the first bounded by timeout and each retry by twice the previous
+// timeout, before returning an error. Non-positive values keep the defaults.
+func (l *Labeler) SetCacheSyncRetry(attempts int, timeout time.Duration) {
+	if attempts > 0 {
+		l.cacheSyncAttempts = attempts
+	}
+
+	if timeout > 0 {
+		l.cacheSyncTimeout = timeout
+	}
+}
+
+// waitForCacheSync waits for the informer caches to sync with bounded, exponentially growing
+// attempts. The informers keep syncing in the background between attempts, so a slow or flaky
+// API server only delays startup instead of failing it on the first timeout.
+func (l *Labeler) waitForCacheSync(ctx context.Context) error {
+	timeout := l.cacheSyncTimeout
+
+	for attempt := 1; attempt <= l.cacheSyncAttempts; attempt++ {
+		slog.Info("Waiting for Labeler caches to sync...",
+			"attempt", attempt,
+			"maxAttempts", l.cacheSyncAttempts,
+			"timeout", timeout)
+
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		synced := cache.WaitForCacheSync(attemptCtx.Done(), l.informersSynced...)
+
+		cancel()
+
+		if synced {
+			slog.Info("Labeler caches synced", "attempt", attempt)
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to wait for caches to sync: %w", ctx.Err())
+		}
+
+		slog.Warn("Labeler caches not synced yet", "attempt", attempt, "timeout", timeout)
+
+		timeout = min(2*timeout, maxCacheSyncTimeout)
+	}
+
+	return fmt.Errorf("failed to wait for caches to sync after %d attempts", l.cacheSyncAttempts)
+}
+
 // getDCGMVersionForNode returns the expected DCGM version for a specific node
 func (l *Labeler) getDCGMVersionForNode(nodeName string) (string, error) {
-	objs, err := l.podInformer.GetIndexer().ByIndex(NodeDCGMIndex, nodeName)
+	objs, err := l.podIndex.ByIndex(NodeDCGMIndex, nodeName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get DCGM pods by node index for node %s: %w", nodeName, err)
 	}
@@ -281,7 +541,11 @@ func (l *Labeler) getDCGMVersionForNode(nodeName string) (string, error) {
 
 // getDriverLabelForNode returns the expected driver label value for a specific node
 func (l *Labeler) getDriverLabelForNode(nodeName string) (string, error) {
-	objs, err := l.podInformer.GetIndexer().ByIndex(NodeDriverIndex, nodeName)
+	if l.driverSource == DriverSourceNode {
+		return l.getDriverLabelFromNode(nodeName)
+	}
+
+	objs, err := l.podIndex.ByIndex(NodeDriverIndex, nodeName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get driver pods by node index for node %s: %w", nodeName, err)
 	}
@@ -300,42 +564,18 @@ func (l *Labeler) getDriverLabelForNode(nodeName string) (string, error) {
 	return "", nil
 }
 
-// getKataLabelForNode detects if Kata is enabled on the specified node by checking node metadata.
-// Returns "true" if Kata is enabled, "false" if not.
-func (l *Labeler) getKataLabelForNode(node *v1.Node) string {
-	// Check if Kata is enabled using multiple detection methods
-	if isKataEnabled(node, l.kataLabels) {
-		return LabelValueTrue
-	}
-
-	return LabelValueFalse
-}
-
 // isKataEnabled checks if a node has Kata Containers enabled by examining node labels.
 // Checks the configured kata labels (either custom override or default) for truthy values.
 // Returns true if ANY of the configured labels has a truthy value (OR logic).
 // Truthy values are: "true", "enabled", "1", "yes" (case-insensitive).
 func isKataEnabled(node *v1.Node, kataLabels []string) bool {
-	for _, label := range kataLabels {
-		if value, exists := node.Labels[label]; exists && stringutil.IsTruthyValue(value) {
-			slog.Debug("Kata detected",
-				"source", "label",
-				"node", node.Name,
-				"label", label,
-				"value", value,
-			)
-
-			return true
-		}
-	}
-
-	return false
+	return hasTruthyKey(node, "label", node.Labels, kataLabels)
 }
 
 // getDCGMVersionForNodeExcluding returns the expected DCGM version for a specific node,
 // excluding a specific pod from consideration (used for delete events)
 func (l *Labeler) getDCGMVersionForNodeExcluding(nodeName string, excludePod *v1.Pod) (string, error) {
-	objs, err := l.podInformer.GetIndexer().ByIndex(NodeDCGMIndex, nodeName)
+	objs, err := l.podIndex.ByIndex(NodeDCGMIndex, nodeName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get DCGM pods by node index for node %s: %w", nodeName, err)
 	}
@@ -366,7 +606,11 @@ func (l *Labeler) getDCGMVersionForNodeExcluding(nodeName string, excludePod *v1
 // getDriverLabelForNodeExcluding returns the expected driver label value for a specific node,
 // excluding a specific pod from consideration (used for delete events)
 func (l *Labeler) getDriverLabelForNodeExcluding(nodeName string, excludePod *v1.Pod) (string, error) {
-	objs, err := l.podInformer.GetIndexer().ByIndex(NodeDriverIndex, nodeName)
+	if l.driverSource == DriverSourceNode {
+		return l.getDriverLabelFromNode(nodeName)
+	}
+
+	objs, err := l.podIndex.ByIndex(NodeDriverIndex, nodeName)
 	if err != nil {
 		return "", fmt.Errorf("failed to get driver pods by node index for node %s: %w", nodeName, err)
 	}
@@ -390,14 +634,20 @@ func (l *Labeler) getDriverLabelForNodeExcluding(nodeName string, excludePod *v1
 	return "", nil
 }
 
-// updateNodeLabelsForPod updates only DCGM and driver labels (kata is handled separately by node events)
-func (l *Labeler) updateNodeLabelsForPod(nodeName, expectedDCGMVersion, expectedDriverLabel string) error {
+// updateNodeLabelsForPod updates only DCGM and driver labels (kata is handled separately by node events).
+// The driver label is removed instead while the node is under maintenance.
+func (l *Labeler) updateNodeLabelsForPod(ctx context.Context, nodeName, expectedDCGMVersion, podDriverLabel string) error {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		node, err := l.clientset.CoreV1().Nodes().Get(l.ctx, nodeName, metav1.GetOptions{})
+		node, err := l.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
 
+		expectedDriverLabel := podDriverLabel
+		if l.isUnderMaintenance(node) {
+			expectedDriverLabel = ""
+		}
+
 		if node.Labels == nil {
 			node.Labels = make(map[string]string)
 		}
@@ -433,7 +683,7 @@ func (l *Labeler) updateNodeLabelsForPod(nodeName, expectedDCGMVersion, expected
 			return nil
 		}
 
-		_, err = l.clientset.CoreV1().Nodes().Update(l.ctx, node, metav1.UpdateOptions{})
+		_, err = l.clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
 
 		return err
 	})
@@ -445,58 +695,171 @@ func (l *Labeler) updateNodeLabelsForPod(nodeName, expectedDCGMVersion, expected
 	return nil
 }
 
-// handleNodeEvent processes node events to update kata detection label
+// handleNodeEvent processes node events to recompute the DCGM and driver labels from the pod
+// indexers and update the kata detection label
 func (l *Labeler) handleNodeEvent(obj any) error {
 	node, ok := obj.(*v1.Node)
 	if !ok {
 		return fmt.Errorf("node event: expected Node object, got %T", obj)
 	}
 
-	expectedKataLabel := l.getKataLabelForNode(node)
+	unlock := l.nodeLocks.lock(node.Name)
+	defer unlock()
+
+	if err := l.reconcilePodLabels(l.ctx, node); err != nil {
+		return err
+	}
+
+	detection, err := l.detectKata(l.ctx, node)
+	if err != nil {
+		return l.handleKataDetectionError(l.ctx, node, detection, err)
+	}
+
+	l.observeKataDetection(node, detection)
 
-	currentKataLabel := node.Labels[KataEnabledLabel]
-	if currentKataLabel == expectedKataLabel {
-		slog.Debug("Node already has correct kata label", "node", node.Name, "kata", expectedKataLabel)
+	expectedLabels := l.detectionLabels(detection)
+	if hasLabels(node, expectedLabels) {
+		slog.Debug("Node already has correct detection labels", "node", node.Name, "labels", expectedLabels)
 		return nil
 	}
 
-	// Only update kata label, leave DCGM/driver labels alone
-	return l.updateKataLabel(node.Name, expectedKataLabel)
+	// Only update the detection labels, leave DCGM/driver labels alone
+	return l.updateDetectionLabels(l.ctx, node.Name, expectedLabels)
+}
+
+// handleNodeUpdateEvent processes node update events. A reboot may change the node runtime
+// configuration (e.g. the GPU operator re-applying Kata), so the cached Kata custom resource result
+// of a node that rebooted or became ready again is dropped and Kata is detected afresh.
+func (l *Labeler) handleNodeUpdateEvent(oldObj, newObj any) error {
+	oldNode, oldOK := oldObj.(*v1.Node)
+	newNode, newOK := newObj.(*v1.Node)
+
+	if oldOK && newOK && nodeRestarted(oldNode, newNode) {
+		slog.Info("Node rebooted or became ready, invalidating cached Kata detection", "node", newNode.Name)
+		l.kataCRResults.forget(newNode.Name)
+	}
+
+	return l.handleNodeEvent(newObj)
+}
+
+// nodeRestarted returns true if the node boot ID changed or the node transitioned to Ready
+func nodeRestarted(oldNode, newNode *v1.Node) bool {
+	oldBootID := oldNode.Status.NodeInfo.BootID
+	newBootID := newNode.Status.NodeInfo.BootID
+
+	if oldBootID != "" && newBootID != "" && oldBootID != newBootID {
+		return true
+	}
+
+	return !isNodeReady(oldNode) && isNodeReady(newNode)
+}
+
+// isNodeReady returns true if the node Ready condition is True
+func isNodeReady(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
 }
 
-// updateKataLabel updates only the kata label on a node
-func (l *Labeler) updateKataLabel(nodeName, expectedKataLabel string) error {
+// handleNodeDeleteEvent drops the per-node Kata detection state of a deleted node
+func (l *Labeler) handleNodeDeleteEvent(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if node, ok := obj.(*v1.Node); ok {
+		l.detections.forget(node.Name)
+		l.kataCRResults.forget(node.Name)
+	}
+}
+
+// reconcilePodLabels recomputes the DCGM and driver labels of a node from the pod indexers.
+// Node events use it so a node registered after its pods were scheduled, or whose maintenance
+// state changed, is labeled without waiting for a pod event. It only writes to the API server
+// when the labels on the node differ from the expected values.
+func (l *Labeler) reconcilePodLabels(ctx context.Context, node *v1.Node) error {
+	expectedDCGMVersion, err := l.getDCGMVersionForNode(node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get DCGM version for node %s: %w", node.Name, err)
+	}
+
+	expectedDriverLabel, err := l.getDriverLabelForNode(node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get driver label for node %s: %w", node.Name, err)
+	}
+
+	if l.isUnderMaintenance(node) {
+		expectedDriverLabel = ""
+	}
+
+	if node.Labels[DCGMVersionLabel] == expectedDCGMVersion && node.Labels[DriverInstalledLabel] == expectedDriverLabel {
+		return nil
+	}
+
+	slog.Info("Node labels out of date with its pods, reconciling",
+		"node", node.Name,
+		"maintenance", l.isUnderMaintenance(node))
+
+	return l.updateNodeLabelsForPod(ctx, node.Name, expectedDCGMVersion, expectedDriverLabel)
+}
+
+// updateDetectionLabels updates only the given kata and runtime feature labels on a node; labels
+// with an empty value are removed
+func (l *Labeler) updateDetectionLabels(ctx context.Context, nodeName string, expectedLabels map[string]string) error {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		node, err := l.clientset.CoreV1().Nodes().Get(l.ctx, nodeName, metav1.GetOptions{})
+		node, err := l.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
 
+		if hasLabels(node, expectedLabels) {
+			slog.Debug("Node already has correct detection labels", "node", nodeName, "labels", expectedLabels)
+			return nil
+		}
+
 		if node.Labels == nil {
 			node.Labels = make(map[string]string)
 		}
 
-		currentKataLabel := node.Labels[KataEnabledLabel]
-		if currentKataLabel == expectedKataLabel {
-			slog.Debug("Node already has correct kata label", "node", nodeName, "kata", expectedKataLabel)
-			return nil
+		for label, value := range expectedLabels {
+			if value == "" {
+				delete(node.Labels, label)
+			} else {
+				node.Labels[label] = value
+			}
 		}
 
-		node.Labels[KataEnabledLabel] = expectedKataLabel
-		slog.Info("Setting Kata enabled label on node", "node", nodeName, "kata", expectedKataLabel)
+		slog.Info("Setting detection labels on node", "node", nodeName, "labels", expectedLabels)
 
-		_, err = l.clientset.CoreV1().Nodes().Update(l.ctx, node, metav1.UpdateOptions{})
+		_, err = l.clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
 
 		return err
 	})
 	if err != nil {
 		metrics.NodeUpdateFailures.Inc()
-		return fmt.Errorf("failed to update kata label for %s: %w", nodeName, err)
+		return fmt.Errorf("failed to update detection labels for %s: %w", nodeName, err)
 	}
 
 	return nil
 }
 
+// hasLabels returns true if the node carries all the labels with the given values and none of the
+// labels with an empty value
+func hasLabels(node *v1.Node, expectedLabels map[string]string) bool {
+	for label, value := range expectedLabels {
+		current, exists := node.Labels[label]
+		if (value == "" && exists) || (value != "" && current != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // handlePodDeleteEvent processes pod delete events by recalculating node labels
 // after excluding the deleted pod from consideration
 func (l *Labeler) handlePodDeleteEvent(obj any) error {
@@ -511,6 +874,9 @@ func (l *Labeler) handlePodDeleteEvent(obj any) error {
 		return fmt.Errorf("pod delete event: expected Pod object, got %T", obj)
 	}
 
+	unlock := l.nodeLocks.lock(pod.Spec.NodeName)
+	defer unlock()
+
 	// For delete events, we need to calculate what the labels should be
 	// after this pod is removed, so we exclude it from our calculations
 	expectedDCGMVersion, err := l.getDCGMVersionForNodeExcluding(pod.Spec.NodeName, pod)
@@ -523,7 +889,7 @@ func (l *Labeler) handlePodDeleteEvent(obj any) error {
 		return fmt.Errorf("failed to get driver label for node %s excluding deleted pod: %w", pod.Spec.NodeName, err)
 	}
 
-	return l.updateNodeLabelsForPod(pod.Spec.NodeName, expectedDCGMVersion, expectedDriverLabel)
+	return l.updateNodeLabelsForPod(l.ctx, pod.Spec.NodeName, expectedDCGMVersion, expectedDriverLabel)
 }
 
 // handlePodEvent processes all pod events (add, update) idempotently
@@ -539,6 +905,9 @@ func (l *Labeler) handlePodEvent(obj any) error {
 		return fmt.Errorf("pod event: expected Pod object, got %T", obj)
 	}
 
+	unlock := l.nodeLocks.lock(pod.Spec.NodeName)
+	defer unlock()
+
 	expectedDCGMVersion, err := l.getDCGMVersionForNode(pod.Spec.NodeName)
 	if err != nil {
 		return fmt.Errorf("failed to get DCGM version for node %s: %w", pod.Spec.NodeName, err)
@@ -549,5 +918,73 @@ func (l *Labeler) handlePodEvent(obj any) error {
 		return fmt.Errorf("failed to get driver label for node %s: %w", pod.Spec.NodeName, err)
 	}
 
-	return l.updateNodeLabelsForPod(pod.Spec.NodeName, expectedDCGMVersion, expectedDriverLabel)
+	return l.updateNodeLabelsForPod(l.ctx, pod.Spec.NodeName, expectedDCGMVersion, expectedDriverLabel)
+}
+
+// ReconcileNode recomputes and applies the DCGM, driver and kata labels for a single node on
+// demand, without waiting for a pod event or the resync sweep. It holds the same per-node lock
+// as the event handlers, so it never races an event-driven update for the same node.
+//
+// Node events reuse the cached Kata custom resource result of the node until its TTL expires, or
+// until it is invalidated in detect once mode; ReconcileNode invalidates it and reads the custom
+// resource again, so it can be used to pick up a Kata change without waiting for the TTL.
+func (l *Labeler) ReconcileNode(ctx context.Context, nodeName string) error {
+	unlock := l.nodeLocks.lock(nodeName)
+	defer unlock()
+
+	l.kataCRResults.forget(nodeName)
+
+	expectedDCGMVersion, err := l.getDCGMVersionForNode(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get DCGM version for node %s: %w", nodeName, err)
+	}
+
+	expectedDriverLabel, err := l.getDriverLabelForNode(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get driver label for node %s: %w", nodeName, err)
+	}
+
+	if err := l.updateNodeLabelsForPod(ctx, nodeName, expectedDCGMVersion, expectedDriverLabel); err != nil {
+		return err
+	}
+
+	node, err := l.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	detection, err := l.detectKata(ctx, node)
+	if err != nil {
+		return l.handleKataDetectionError(ctx, node, detection, err)
+	}
+
+	l.observeKataDetection(node, detection)
+
+	return l.updateDetectionLabels(ctx, nodeName, l.detectionLabels(detection))
+}
+
+// InvalidateKataDetection drops the cached custom resource detection result of the node, or of every
+// node when nodeName is empty, and detects Kata on the nodes again. Nodes not in the informer cache
+// are detected on their next event.
+func (l *Labeler) InvalidateKataDetection(nodeName string) error {
+	if nodeName == "" {
+		l.kataCRResults.forgetAll()
+	} else {
+		l.kataCRResults.forget(nodeName)
+	}
+
+	var errs []error
+
+	for _, obj := range l.nodeInformer.GetStore().List() {
+		node, ok := obj.(*v1.Node)
+		if !ok || (nodeName != "" && node.Name != nodeName) {
+			continue
+		}
+
+		if err := l.handleNodeEvent(node); err != nil {
+			errs = append(errs, fmt.Errorf("failed to re-detect kata on node %s: %w", node.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
 }
@@ -21,10 +21,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 )
@@ -553,7 +556,7 @@ func TestLabeler_handlePodEvent(t *testing.T) {
 				require.NoError(t, err, "failed to update pod status")
 			}
 
-			labeler, err := NewLabeler(cli, time.Minute, "nvidia-dcgm", "nvidia-driver-daemonset", "")
+			labeler, err := NewLabeler(cli, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
 			require.NoError(t, err)
 			go func() {
 				require.NoError(t, labeler.Run(ctx), "failed to run labeler")
@@ -671,8 +674,8 @@ func TestKataLabelOverride(t *testing.T) {
 			l, err := NewLabeler(
 				clientset,
 				time.Minute,
-				"nvidia-dcgm",
-				"nvidia-driver-daemonset",
+				[]string{"nvidia-dcgm"},
+				[]string{"nvidia-driver-daemonset"},
 				tt.override,
 			)
 
@@ -707,8 +710,8 @@ func TestKataLabelOverrideIsolation(t *testing.T) {
 	l1, err := NewLabeler(
 		clientset,
 		time.Minute,
-		"nvidia-dcgm",
-		"nvidia-driver-daemonset",
+		[]string{"nvidia-dcgm"},
+		[]string{"nvidia-driver-daemonset"},
 		"first.io/kata",
 	)
 	if err != nil {
@@ -719,8 +722,8 @@ func TestKataLabelOverrideIsolation(t *testing.T) {
 	l2, err := NewLabeler(
 		clientset,
 		time.Minute,
-		"nvidia-dcgm",
-		"nvidia-driver-daemonset",
+		[]string{"nvidia-dcgm"},
+		[]string{"nvidia-driver-daemonset"},
 		"second.io/kata",
 	)
 	if err != nil {
@@ -731,8 +734,8 @@ func TestKataLabelOverrideIsolation(t *testing.T) {
 	l3, err := NewLabeler(
 		clientset,
 		time.Minute,
-		"nvidia-dcgm",
-		"nvidia-driver-daemonset",
+		[]string{"nvidia-dcgm"},
+		[]string{"nvidia-driver-daemonset"},
 		"",
 	)
 	if err != nil {
@@ -859,7 +862,7 @@ func TestKataLabelDetection(t *testing.T) {
 			require.NoError(t, err, "failed to create node")
 
 			// Create labeler with kata override if specified
-			labeler, err := NewLabeler(cli, time.Minute, "nvidia-dcgm", "nvidia-driver-daemonset", tt.kataOverride)
+			labeler, err := NewLabeler(cli, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, tt.kataOverride)
 			require.NoError(t, err, "failed to create labeler")
 
 			// Start labeler
@@ -907,3 +910,374 @@ func TestKataLabelDetection(t *testing.T) {
 		})
 	}
 }
+
+func TestNewLabeler_ResyncPeriod(t *testing.T) {
+	tests := []struct {
+		name           string
+		resyncPeriod   time.Duration
+		expectedPeriod time.Duration
+		expectErr      bool
+	}{
+		{
+			name:           "zero applies default",
+			resyncPeriod:   0,
+			expectedPeriod: DefaultResyncPeriod,
+		},
+		{
+			name:         "negative is rejected",
+			resyncPeriod: -time.Second,
+			expectErr:    true,
+		},
+		{
+			name:           "valid value is kept",
+			resyncPeriod:   5 * time.Minute,
+			expectedPeriod: 5 * time.Minute,
+		},
+		{
+			name:           "small value is kept with a warning",
+			resyncPeriod:   time.Second,
+			expectedPeriod: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), tt.resyncPeriod,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Nil(t, l)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPeriod, l.resyncPeriod)
+		})
+	}
+}
+
+func TestLabeler_ReconcileNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+			Labels: map[string]string{
+				KataRuntimeDefaultLabel: "true",
+				DCGMVersionLabel:        "3.x",
+			},
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "dcgm-pod",
+			UID:    "dcgm-uid",
+			Labels: map[string]string{"app": "nvidia-dcgm"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "test-node",
+			Containers: []corev1.Container{{Name: "dcgm", Image: "nvcr.io/nvidia/dcgm:4.1.0"}},
+		},
+	}))
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "driver-pod",
+			UID:    "driver-uid",
+			Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+		},
+		Spec: corev1.PodSpec{NodeName: "test-node"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}))
+
+	require.NoError(t, l.ReconcileNode(context.Background(), "test-node"))
+
+	updated, err := clientset.CoreV1().Nodes().Get(context.Background(), "test-node", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "4.x", updated.Labels[DCGMVersionLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[DriverInstalledLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+	assert.Equal(t, 0, l.nodeLocks.size())
+
+	// Reconciling again is a no-op once the labels have converged
+	require.NoError(t, l.ReconcileNode(context.Background(), "test-node"))
+
+	assert.Error(t, l.ReconcileNode(context.Background(), "missing-node"))
+}
+
+func TestLabeler_NodeAddedAfterPods(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	// The pods were scheduled (and their events handled) before the node was registered
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "dcgm-pod",
+			UID:    "dcgm-uid",
+			Labels: map[string]string{"app": "nvidia-dcgm"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "new-node",
+			Containers: []corev1.Container{{Name: "dcgm", Image: "nvcr.io/nvidia/dcgm:4.1.0"}},
+		},
+	}))
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "driver-pod",
+			UID:    "driver-uid",
+			Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+		},
+		Spec: corev1.PodSpec{NodeName: "new-node"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}))
+
+	node, err := clientset.CoreV1().Nodes().Create(ctx,
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "new-node"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, l.handleNodeEvent(node))
+
+	updated, err := clientset.CoreV1().Nodes().Get(ctx, "new-node", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "4.x", updated.Labels[DCGMVersionLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[DriverInstalledLabel])
+	assert.Equal(t, LabelValueFalse, updated.Labels[KataEnabledLabel])
+
+	// A later update event for the labeled node does not write again
+	clientset.ClearActions()
+	require.NoError(t, l.handleNodeEvent(updated))
+
+	for _, action := range clientset.Actions() {
+		assert.NotEqual(t, "update", action.GetVerb(), "unexpected node update")
+	}
+}
+
+func TestLabeler_MultipleDriverApps(t *testing.T) {
+	driverPod := func(name, app string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				UID:    types.UID(name + "-uid"),
+				Labels: map[string]string{"app": app},
+			},
+			Spec: corev1.PodSpec{NodeName: "test-node"},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		pods          []*corev1.Pod
+		expectedLabel string
+	}{
+		{
+			name: "ready pod from the second driver app",
+			pods: []*corev1.Pod{
+				driverPod("operator-driver", "nvidia-driver-daemonset", false),
+				driverPod("vendor-driver", "vendor-precompiled-driver", true),
+			},
+			expectedLabel: LabelValueTrue,
+		},
+		{
+			name: "ready pod from the first driver app",
+			pods: []*corev1.Pod{
+				driverPod("operator-driver", "nvidia-driver-daemonset", true),
+				driverPod("vendor-driver", "vendor-precompiled-driver", false),
+			},
+			expectedLabel: LabelValueTrue,
+		},
+		{
+			name: "no ready pod from any driver app",
+			pods: []*corev1.Pod{
+				driverPod("operator-driver", "nvidia-driver-daemonset", false),
+				driverPod("vendor-driver", "vendor-precompiled-driver", false),
+			},
+			expectedLabel: "",
+		},
+		{
+			name: "ready pod from an unconfigured app is ignored",
+			pods: []*corev1.Pod{
+				driverPod("other-driver", "other-driver", true),
+			},
+			expectedLabel: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}})
+
+			l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"},
+				[]string{"nvidia-driver-daemonset", "vendor-precompiled-driver"}, "")
+			require.NoError(t, err)
+
+			for _, pod := range tt.pods {
+				require.NoError(t, l.podInformer.GetIndexer().Add(pod))
+			}
+
+			require.NoError(t, l.ReconcileNode(context.Background(), "test-node"))
+
+			node, err := clientset.CoreV1().Nodes().Get(context.Background(), "test-node", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLabel, node.Labels[DriverInstalledLabel])
+		})
+	}
+}
+
+func TestNewLabeler_KataLabelOverride(t *testing.T) {
+	tests := []struct {
+		name          string
+		override      string
+		expectErr     bool
+		expectedLabel []string
+	}{
+		{
+			name:          "empty uses only the default label",
+			override:      "",
+			expectedLabel: []string{KataRuntimeDefaultLabel},
+		},
+		{
+			name:          "whitespace only is treated as empty",
+			override:      "  ",
+			expectedLabel: []string{KataRuntimeDefaultLabel},
+		},
+		{
+			name:          "prefixed key",
+			override:      "example.com/kata-enabled",
+			expectedLabel: []string{KataRuntimeDefaultLabel, "example.com/kata-enabled"},
+		},
+		{
+			name:          "surrounding whitespace is trimmed",
+			override:      " kata.enabled\n",
+			expectedLabel: []string{KataRuntimeDefaultLabel, "kata.enabled"},
+		},
+		{
+			name:      "invalid characters",
+			override:  "kata enabled",
+			expectErr: true,
+		},
+		{
+			name:      "invalid prefix",
+			override:  "Example_Com/kata",
+			expectErr: true,
+		},
+		{
+			name:      "too many slashes",
+			override:  "example.com/kata/enabled",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, tt.override)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLabel, l.kataLabels)
+		})
+	}
+}
+
+func TestNewLabeler_RequiresAppLabels(t *testing.T) {
+	_, err := NewLabeler(fake.NewSimpleClientset(), time.Minute, nil, []string{"nvidia-driver-daemonset"}, "")
+	assert.Error(t, err)
+
+	_, err = NewLabeler(fake.NewSimpleClientset(), time.Minute, []string{"nvidia-dcgm"}, nil, "")
+	assert.Error(t, err)
+}
+
+func TestLabeler_WaitForCacheSyncRetries(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.SetCacheSyncRetry(3, 150*time.Millisecond)
+
+	// The caches report synced only after the first attempt has timed out
+	start := time.Now()
+	l.informersSynced = []cache.InformerSynced{func() bool {
+		return time.Since(start) > 250*time.Millisecond
+	}}
+
+	require.NoError(t, l.waitForCacheSync(context.Background()))
+	assert.Greater(t, time.Since(start), 150*time.Millisecond, "first attempt should have timed out")
+}
+
+func TestLabeler_WaitForCacheSyncGivesUp(t *testing.T) {
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.SetCacheSyncRetry(2, 50*time.Millisecond)
+	l.informersSynced = []cache.InformerSynced{func() bool { return false }}
+
+	err = l.waitForCacheSync(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after 2 attempts")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, l.waitForCacheSync(ctx), context.Canceled)
+}
+
+func TestLabeler_CloseStopsRun(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	require.NoError(t, l.SetKataRuntimeClassDetection(true))
+
+	done := make(chan error, 1)
+	go func() { done <- l.Run(context.Background()) }()
+
+	require.Eventually(t, func() bool {
+		_, labeled := getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel]
+		return labeled
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, l.Close())
+	require.NoError(t, <-done)
+
+	// Closing again is a no-op
+	require.NoError(t, l.Close())
+}
+
+func TestLabeler_CloseBeforeRun(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, l.Close())
+	assert.NoError(t, l.Run(context.Background()))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// MaintenanceMarker identifies nodes under maintenance. A node carrying the annotation (any value)
+// or a taint with the taint key is under maintenance; empty fields are not checked.
+type MaintenanceMarker struct {
+	Annotation string
+	TaintKey   string
+}
+
+// SetMaintenanceMarker enables maintenance mode. While a node carries the marker, the labels that
+// imply the node is ready for GPU workloads (driver.installed) are removed so nothing new is
+// scheduled on it; once the marker is removed they are recomputed and restored. An empty marker
+// disables maintenance mode.
+func (l *Labeler) SetMaintenanceMarker(marker MaintenanceMarker) {
+	l.maintenance = marker
+}
+
+// isUnderMaintenance returns true if the node carries the configured maintenance marker
+func (l *Labeler) isUnderMaintenance(node *v1.Node) bool {
+	if l.maintenance.Annotation != "" {
+		if _, exists := node.Annotations[l.maintenance.Annotation]; exists {
+			return true
+		}
+	}
+
+	if l.maintenance.TaintKey != "" {
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == l.maintenance.TaintKey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
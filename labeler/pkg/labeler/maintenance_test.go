@@ -0,0 +1,136 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const maintenanceAnnotation = "example.com/maintenance"
+
+func newMaintenanceTestLabeler(t *testing.T, node *corev1.Node) (*Labeler, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.SetMaintenanceMarker(MaintenanceMarker{Annotation: maintenanceAnnotation, TaintKey: "example.com/maintenance"})
+
+	require.NoError(t, l.podInformer.GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "driver-pod",
+			UID:    "driver-uid",
+			Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+		},
+		Spec: corev1.PodSpec{NodeName: node.Name},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}))
+
+	return l, clientset
+}
+
+func getTestNode(t *testing.T, clientset *fake.Clientset, name string) *corev1.Node {
+	t.Helper()
+
+	node, err := clientset.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return node
+}
+
+func TestMaintenance_SuppressesAndRestoresDriverLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		enter func(node *corev1.Node)
+		exit  func(node *corev1.Node)
+	}{
+		{
+			name: "annotation",
+			enter: func(node *corev1.Node) {
+				node.Annotations = map[string]string{maintenanceAnnotation: ""}
+			},
+			exit: func(node *corev1.Node) {
+				delete(node.Annotations, maintenanceAnnotation)
+			},
+		},
+		{
+			name: "taint",
+			enter: func(node *corev1.Node) {
+				node.Spec.Taints = []corev1.Taint{{Key: "example.com/maintenance", Effect: corev1.TaintEffectNoSchedule}}
+			},
+			exit: func(node *corev1.Node) {
+				node.Spec.Taints = nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-node",
+				Labels: map[string]string{DriverInstalledLabel: LabelValueTrue},
+			}}
+			l, clientset := newMaintenanceTestLabeler(t, node)
+			ctx := context.Background()
+
+			// Entering maintenance suppresses the driver label
+			node = getTestNode(t, clientset, node.Name)
+			tt.enter(node)
+			node, err := clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			require.NoError(t, l.handleNodeEvent(node))
+			assert.NotContains(t, getTestNode(t, clientset, node.Name).Labels, DriverInstalledLabel)
+
+			// Pod events during maintenance keep it suppressed
+			require.NoError(t, l.ReconcileNode(ctx, node.Name))
+			assert.NotContains(t, getTestNode(t, clientset, node.Name).Labels, DriverInstalledLabel)
+
+			// Exiting maintenance restores it from the running driver pod
+			node = getTestNode(t, clientset, node.Name)
+			tt.exit(node)
+			node, err = clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			require.NoError(t, l.handleNodeEvent(node))
+			assert.Equal(t, LabelValueTrue, getTestNode(t, clientset, node.Name).Labels[DriverInstalledLabel])
+		})
+	}
+}
+
+func TestMaintenance_DisabledLeavesLabels(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:        "test-node",
+		Labels:      map[string]string{DriverInstalledLabel: LabelValueTrue},
+		Annotations: map[string]string{maintenanceAnnotation: ""},
+	}}
+	l, clientset := newMaintenanceTestLabeler(t, node)
+	l.SetMaintenanceMarker(MaintenanceMarker{})
+
+	require.NoError(t, l.handleNodeEvent(node))
+	assert.Equal(t, LabelValueTrue, getTestNode(t, clientset, node.Name).Labels[DriverInstalledLabel])
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import "sync"
+
+// nodeLocks provides per-node mutual exclusion so that label computation and
+// writes for the same node are serialized across concurrent event handlers.
+// Entries are reference counted and removed once no goroutine holds or waits
+// on them, so the map does not grow with the number of nodes ever seen.
+type nodeLocks struct {
+	mu    sync.Mutex
+	locks map[string]*nodeLock
+}
+
+type nodeLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func newNodeLocks() *nodeLocks {
+	return &nodeLocks{locks: make(map[string]*nodeLock)}
+}
+
+// lock acquires the lock for the given node and returns a function that releases it
+func (n *nodeLocks) lock(nodeName string) func() {
+	n.mu.Lock()
+
+	l, exists := n.locks[nodeName]
+	if !exists {
+		l = &nodeLock{}
+		n.locks[nodeName] = l
+	}
+
+	l.refs++
+	n.mu.Unlock()
+
+	l.mu.Lock()
+
+	return func() {
+		l.mu.Unlock()
+
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		l.refs--
+		if l.refs == 0 {
+			delete(n.locks, nodeName)
+		}
+	}
+}
+
+// size returns the number of nodes that currently have a lock entry
+func (n *nodeLocks) size() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return len(n.locks)
+}
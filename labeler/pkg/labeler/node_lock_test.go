@@ -0,0 +1,174 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeLocks_SerializesSameNode(t *testing.T) {
+	locks := newNodeLocks()
+
+	var (
+		inFlight    int32
+		maxInFlight int32
+		wg          sync.WaitGroup
+	)
+
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.lock("node-a")
+			defer unlock()
+
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxInFlight, "only one goroutine should hold a node's lock at a time")
+	assert.Equal(t, 0, locks.size(), "lock entries should be released once unused")
+}
+
+func TestNodeLocks_DifferentNodesDoNotBlock(t *testing.T) {
+	locks := newNodeLocks()
+
+	unlockA := locks.lock("node-a")
+	defer unlockA()
+
+	done := make(chan struct{})
+
+	go func() {
+		unlockB := locks.lock("node-b")
+		unlockB()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lock on node-b blocked behind node-a")
+	}
+
+	assert.Equal(t, 1, locks.size())
+}
+
+// TestLabeler_ConcurrentEventsSameNode fires many pod and node events for one node concurrently
+// and verifies the final label set is consistent. Run with -race.
+func TestLabeler_ConcurrentEventsSameNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-node",
+			Labels: map[string]string{KataRuntimeDefaultLabel: "true"},
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(node)
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	dcgmPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "dcgm-pod",
+			UID:    "dcgm-uid",
+			Labels: map[string]string{"app": "nvidia-dcgm"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "test-node",
+			Containers: []corev1.Container{{Name: "dcgm", Image: "nvcr.io/nvidia/dcgm:4.1.0"}},
+		},
+	}
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "driver-pod",
+			UID:    "driver-uid",
+			Labels: map[string]string{"app": "nvidia-driver-daemonset"},
+		},
+		Spec: corev1.PodSpec{NodeName: "test-node"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	require.NoError(t, l.podInformer.GetIndexer().Add(dcgmPod))
+	require.NoError(t, l.podInformer.GetIndexer().Add(driverPod))
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 100)
+
+	for i := range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var err error
+
+			switch i % 3 {
+			case 0:
+				err = l.handlePodEvent(dcgmPod)
+			case 1:
+				err = l.handlePodEvent(driverPod)
+			default:
+				err = l.handleNodeEvent(node)
+			}
+
+			if err != nil {
+				errs <- fmt.Errorf("event %d: %w", i, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	updated, err := clientset.CoreV1().Nodes().Get(context.Background(), "test-node", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "4.x", updated.Labels[DCGMVersionLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[DriverInstalledLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+	assert.Equal(t, 0, l.nodeLocks.size())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultOperatorGatePollInterval is how often the GPU operator gate looks for the DaemonSets
+const DefaultOperatorGatePollInterval = 10 * time.Second
+
+// OperatorGate defers labeling until the GPU operator DaemonSets exist, so that a labeler started
+// during cluster bootstrap does not flap the DCGM and driver labels while the operator is deployed
+type OperatorGate struct {
+	// Namespace the DaemonSets are looked up in; empty looks in all namespaces
+	Namespace string
+	// Timeout bounds the wait, after which labeling starts anyway; zero waits indefinitely
+	Timeout time.Duration
+}
+
+// SetOperatorGate makes Run wait, before it starts watching pods and nodes, until a DaemonSet
+// running the DCGM pods and one running the driver pods exist, matched by the "app" label of their
+// pod templates. A nil gate, the default, starts labeling immediately.
+func (l *Labeler) SetOperatorGate(gate *OperatorGate) {
+	l.operatorGate = gate
+}
+
+// waitForOperator blocks until the operator gate opens, its timeout elapses or ctx is done
+func (l *Labeler) waitForOperator(ctx context.Context) error {
+	if l.operatorGate == nil {
+		return nil
+	}
+
+	waitCtx := ctx
+
+	if l.operatorGate.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		waitCtx, cancel = context.WithTimeout(ctx, l.operatorGate.Timeout)
+		defer cancel()
+	}
+
+	slog.Info("Waiting for the GPU operator DaemonSets before labeling",
+		"namespace", l.operatorGate.Namespace,
+		"dcgmApps", l.dcgmAppLabels,
+		"driverApps", l.driverAppLabels,
+		"timeout", l.operatorGate.Timeout)
+
+	err := wait.PollUntilContextCancel(waitCtx, l.operatorGatePollInterval, true, l.operatorDaemonSetsPresent)
+
+	switch {
+	case err == nil:
+		slog.Info("GPU operator DaemonSets found, starting labeling")
+		return nil
+	case ctx.Err() != nil:
+		return fmt.Errorf("failed to wait for the GPU operator DaemonSets: %w", ctx.Err())
+	case errors.Is(err, context.DeadlineExceeded):
+		slog.Warn("GPU operator DaemonSets not found before the timeout, starting labeling anyway",
+			"timeout", l.operatorGate.Timeout)
+
+		return nil
+	default:
+		return fmt.Errorf("failed to wait for the GPU operator DaemonSets: %w", err)
+	}
+}
+
+// operatorDaemonSetsPresent returns true if DaemonSets running the DCGM and the driver pods exist.
+// List errors are logged and retried on the next poll.
+func (l *Labeler) operatorDaemonSetsPresent(ctx context.Context) (bool, error) {
+	daemonSets, err := l.clientset.AppsV1().DaemonSets(l.operatorGate.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Warn("Failed to list DaemonSets, retrying", "namespace", l.operatorGate.Namespace, "error", err)
+		return false, nil
+	}
+
+	dcgmFound := false
+	driverFound := false
+
+	for _, daemonSet := range daemonSets.Items {
+		app := daemonSet.Spec.Template.Labels["app"]
+		dcgmFound = dcgmFound || slices.Contains(l.dcgmAppLabels, app)
+		driverFound = driverFound || slices.Contains(l.driverAppLabels, app)
+	}
+
+	if !dcgmFound || !driverFound {
+		slog.Debug("GPU operator DaemonSets not present yet", "dcgm", dcgmFound, "driver", driverFound)
+	}
+
+	return dcgmFound && driverFound, nil
+}
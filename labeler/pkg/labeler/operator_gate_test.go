@@ -0,0 +1,127 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newOperatorDaemonSet(name, app string) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "gpu-operator"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": app}},
+			},
+		},
+	}
+}
+
+func newOperatorGateTestLabeler(t *testing.T, clientset *fake.Clientset, gate *OperatorGate) *Labeler {
+	t.Helper()
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+
+	l.SetOperatorGate(gate)
+	l.operatorGatePollInterval = 10 * time.Millisecond
+
+	return l
+}
+
+func TestOperatorGate_DefersLabelingUntilDaemonSetsAppear(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "test-node",
+		Labels: map[string]string{KataRuntimeDefaultLabel: "true"},
+	}}
+	clientset := fake.NewSimpleClientset(node)
+	l := newOperatorGateTestLabeler(t, clientset, &OperatorGate{Namespace: "gpu-operator"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- l.Run(ctx) }()
+
+	// Only the DCGM DaemonSet exists, so the node is not labeled yet
+	_, err := clientset.AppsV1().DaemonSets("gpu-operator").Create(ctx,
+		newOperatorDaemonSet("nvidia-dcgm", "nvidia-dcgm"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Never(t, func() bool {
+		_, labeled := getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel]
+		return labeled
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	// The driver DaemonSet opens the gate
+	_, err = clientset.AppsV1().DaemonSets("gpu-operator").Create(ctx,
+		newOperatorDaemonSet("nvidia-driver-daemonset", "nvidia-driver-daemonset"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel] == LabelValueTrue
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestOperatorGate_WaitForOperator(t *testing.T) {
+	t.Run("no gate does not wait", func(t *testing.T) {
+		l := newOperatorGateTestLabeler(t, fake.NewSimpleClientset(), nil)
+
+		assert.NoError(t, l.waitForOperator(context.Background()))
+	})
+
+	t.Run("DaemonSets in another namespace do not open the gate", func(t *testing.T) {
+		dcgm := newOperatorDaemonSet("nvidia-dcgm", "nvidia-dcgm")
+		driver := newOperatorDaemonSet("nvidia-driver-daemonset", "nvidia-driver-daemonset")
+		l := newOperatorGateTestLabeler(t, fake.NewSimpleClientset(dcgm, driver), &OperatorGate{Namespace: "other"})
+
+		present, err := l.operatorDaemonSetsPresent(context.Background())
+		require.NoError(t, err)
+		assert.False(t, present)
+
+		l.SetOperatorGate(&OperatorGate{})
+
+		present, err = l.operatorDaemonSetsPresent(context.Background())
+		require.NoError(t, err)
+		assert.True(t, present)
+	})
+
+	t.Run("timeout starts labeling anyway", func(t *testing.T) {
+		l := newOperatorGateTestLabeler(t, fake.NewSimpleClientset(), &OperatorGate{Timeout: 50 * time.Millisecond})
+
+		assert.NoError(t, l.waitForOperator(context.Background()))
+	})
+
+	t.Run("cancellation fails the wait", func(t *testing.T) {
+		l := newOperatorGateTestLabeler(t, fake.NewSimpleClientset(), &OperatorGate{})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.Error(t, l.waitForOperator(ctx))
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ PodIndex = (cache.Indexer)(nil)
+
+// PodIndex looks up the DCGM and driver pods indexed by node name (NodeDCGMIndex and
+// NodeDriverIndex). The pod informer's indexer satisfies it; tests inject fakes to exercise
+// the label selection logic without running an informer.
+type PodIndex interface {
+	ByIndex(indexName, indexedValue string) ([]any, error)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// fakePodIndex serves canned index results keyed by index name and node name
+type fakePodIndex struct {
+	objs map[string]map[string][]any
+	err  error
+}
+
+func (f *fakePodIndex) ByIndex(indexName, indexedValue string) ([]any, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.objs[indexName][indexedValue], nil
+}
+
+func dcgmPodWithImage(uid, image string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: uid, UID: types.UID(uid)},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "dcgm", Image: image}}},
+	}
+}
+
+func driverPodWithReady(uid string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: uid, UID: types.UID(uid)},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestGetDCGMVersionForNode_VersionSelection(t *testing.T) {
+	tests := []struct {
+		name     string
+		objs     []any
+		expected string
+	}{
+		{
+			name:     "no pods",
+			expected: "",
+		},
+		{
+			name:     "dcgm 4.x image",
+			objs:     []any{dcgmPodWithImage("a", "nvcr.io/nvidia/cloud-native/dcgm:4.2.3-1-ubuntu22.04")},
+			expected: "4.x",
+		},
+		{
+			name:     "dcgm 3.x image",
+			objs:     []any{dcgmPodWithImage("a", "nvcr.io/nvidia/cloud-native/dcgm:3.3.5-1-ubuntu22.04")},
+			expected: "3.x",
+		},
+		{
+			name:     "unrecognized image",
+			objs:     []any{dcgmPodWithImage("a", "nvcr.io/nvidia/cloud-native/dcgm:latest")},
+			expected: "",
+		},
+		{
+			name: "skips non-pod objects and unrecognized images",
+			objs: []any{
+				"not-a-pod",
+				dcgmPodWithImage("a", "nvcr.io/nvidia/cloud-native/dcgm:latest"),
+				dcgmPodWithImage("b", "nvcr.io/nvidia/cloud-native/dcgm:3.3.5-1-ubuntu22.04"),
+			},
+			expected: "3.x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &Labeler{podIndex: &fakePodIndex{objs: map[string]map[string][]any{
+				NodeDCGMIndex: {"node-1": tt.objs},
+			}}}
+
+			version, err := l.getDCGMVersionForNode("node-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
+
+func TestGetDCGMVersionForNodeExcluding(t *testing.T) {
+	deleted := dcgmPodWithImage("deleted", "nvcr.io/nvidia/cloud-native/dcgm:4.2.3-1-ubuntu22.04")
+	remaining := dcgmPodWithImage("remaining", "nvcr.io/nvidia/cloud-native/dcgm:3.3.5-1-ubuntu22.04")
+
+	l := &Labeler{podIndex: &fakePodIndex{objs: map[string]map[string][]any{
+		NodeDCGMIndex: {"node-1": {deleted, remaining}},
+	}}}
+
+	version, err := l.getDCGMVersionForNodeExcluding("node-1", deleted)
+	require.NoError(t, err)
+	assert.Equal(t, "3.x", version)
+
+	version, err = l.getDCGMVersionForNodeExcluding("node-1", remaining)
+	require.NoError(t, err)
+	assert.Equal(t, "4.x", version)
+}
+
+func TestGetDriverLabelForNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		objs     []any
+		expected string
+	}{
+		{
+			name:     "no pods",
+			expected: "",
+		},
+		{
+			name:     "ready pod",
+			objs:     []any{driverPodWithReady("a", true)},
+			expected: LabelValueTrue,
+		},
+		{
+			name:     "not ready pod",
+			objs:     []any{driverPodWithReady("a", false)},
+			expected: "",
+		},
+		{
+			name:     "any ready pod",
+			objs:     []any{driverPodWithReady("a", false), driverPodWithReady("b", true)},
+			expected: LabelValueTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &Labeler{podIndex: &fakePodIndex{objs: map[string]map[string][]any{
+				NodeDriverIndex: {"node-1": tt.objs},
+			}}}
+
+			label, err := l.getDriverLabelForNode("node-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, label)
+		})
+	}
+}
+
+func TestGetDriverLabelForNodeExcluding(t *testing.T) {
+	deleted := driverPodWithReady("deleted", true)
+	notReady := driverPodWithReady("not-ready", false)
+
+	l := &Labeler{podIndex: &fakePodIndex{objs: map[string]map[string][]any{
+		NodeDriverIndex: {"node-1": {deleted, notReady}},
+	}}}
+
+	label, err := l.getDriverLabelForNodeExcluding("node-1", deleted)
+	require.NoError(t, err)
+	assert.Empty(t, label, "only the deleted pod was ready")
+
+	label, err = l.getDriverLabelForNodeExcluding("node-1", notReady)
+	require.NoError(t, err)
+	assert.Equal(t, LabelValueTrue, label)
+}
+
+func TestPodIndexLookupErrors(t *testing.T) {
+	l := &Labeler{podIndex: &fakePodIndex{err: errors.New("index not found")}}
+	pod := &corev1.Pod{}
+
+	_, err := l.getDCGMVersionForNode("node-1")
+	assert.Error(t, err)
+
+	_, err = l.getDriverLabelForNode("node-1")
+	assert.Error(t, err)
+
+	_, err = l.getDCGMVersionForNodeExcluding("node-1", pod)
+	assert.Error(t, err)
+
+	_, err = l.getDriverLabelForNodeExcluding("node-1", pod)
+	assert.Error(t, err)
+}
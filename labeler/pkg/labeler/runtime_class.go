@@ -0,0 +1,217 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// kataRuntimeHandlerPrefix prefixes the handlers of Kata RuntimeClasses, e.g. kata-qemu or kata-qemu-nvidia-gpu
+const kataRuntimeHandlerPrefix = "kata"
+
+// kataSelectorCache caches the node selectors of the Kata RuntimeClasses across node detections. The
+// RuntimeClass informer bumps the generation on every change it delivers, so the selectors are only
+// recomputed from the informer cache after a RuntimeClass changed and are served as is otherwise.
+type kataSelectorCache struct {
+	mu sync.Mutex
+	// generation counts the RuntimeClass changes observed by the informer
+	generation uint64
+	// cachedGeneration is the generation selectors were computed at, valid when cached is set
+	cachedGeneration uint64
+	cached           bool
+	selectors        []labels.Selector
+}
+
+// invalidate records a RuntimeClass change, so that the next get recomputes the selectors
+func (c *kataSelectorCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+}
+
+// get returns the cached selectors, computing them with compute if a RuntimeClass changed since
+// they were cached. A failed computation is not cached.
+func (c *kataSelectorCache) get(compute func() ([]labels.Selector, error)) ([]labels.Selector, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached && c.cachedGeneration == c.generation {
+		return c.selectors, nil
+	}
+
+	selectors, err := compute()
+	if err != nil {
+		return nil, err
+	}
+
+	c.selectors = selectors
+	c.cachedGeneration = c.generation
+	c.cached = true
+
+	return selectors, nil
+}
+
+// SetKataRuntimeClassDetection enables Kata detection from RuntimeClasses. A node is Kata-enabled when
+// a RuntimeClass with a kata handler schedules its pods onto the node with a node selector; classes
+// without a node selector do not tell which nodes run Kata and are ignored. The RuntimeClasses are
+// watched, so that adding or removing one re-detects the nodes it selects right away rather than on
+// the next resync. It must be called before Run.
+func (l *Labeler) SetKataRuntimeClassDetection(enabled bool) error {
+	if !enabled || l.runtimeClassInformer != nil {
+		return nil
+	}
+
+	runtimeClasses := informers.NewSharedInformerFactory(l.clientset, l.resyncPeriod).Node().V1().RuntimeClasses()
+	informer := runtimeClasses.Informer()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			l.handleRuntimeClassEvent(obj)
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldClass, oldOk := oldObj.(*nodev1.RuntimeClass)
+			newClass, newOk := newObj.(*nodev1.RuntimeClass)
+
+			if oldOk && newOk && oldClass.Handler == newClass.Handler &&
+				equality.Semantic.DeepEqual(oldClass.Scheduling, newClass.Scheduling) {
+				return
+			}
+
+			l.handleRuntimeClassEvent(oldObj, newObj)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+
+			l.handleRuntimeClassEvent(obj)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add runtime class event handler: %w", err)
+	}
+
+	l.runtimeClassInformer = informer
+	l.runtimeClasses = runtimeClasses.Lister()
+	l.informersSynced = append(l.informersSynced, informer.HasSynced)
+
+	return nil
+}
+
+// kataRuntimeClassSelector returns the node selector of a Kata RuntimeClass, or false if the class
+// is not a Kata class or does not select nodes
+func kataRuntimeClassSelector(runtimeClass *nodev1.RuntimeClass) (labels.Selector, bool) {
+	if !strings.HasPrefix(strings.ToLower(runtimeClass.Handler), kataRuntimeHandlerPrefix) {
+		return nil, false
+	}
+
+	if runtimeClass.Scheduling == nil || len(runtimeClass.Scheduling.NodeSelector) == 0 {
+		return nil, false
+	}
+
+	return labels.SelectorFromSet(runtimeClass.Scheduling.NodeSelector), true
+}
+
+// hasKataRuntimeClass reports whether a Kata RuntimeClass selects the node. The selectors of the Kata
+// RuntimeClasses are cached until a RuntimeClass changes, and are then recomputed from the informer
+// cache, without an API call.
+func (l *Labeler) hasKataRuntimeClass(node *v1.Node) bool {
+	if l.runtimeClasses == nil {
+		return false
+	}
+
+	selectors, err := l.kataSelectors.get(l.listKataRuntimeClassSelectors)
+	if err != nil {
+		slog.Error("Failed to list runtime classes", "error", err)
+		return false
+	}
+
+	return slices.ContainsFunc(selectors, func(selector labels.Selector) bool {
+		return selector.Matches(labels.Set(node.Labels))
+	})
+}
+
+// listKataRuntimeClassSelectors returns the node selectors of the Kata RuntimeClasses in the informer cache
+func (l *Labeler) listKataRuntimeClassSelectors() ([]labels.Selector, error) {
+	runtimeClasses, err := l.runtimeClasses.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var selectors []labels.Selector
+
+	for _, runtimeClass := range runtimeClasses {
+		if selector, ok := kataRuntimeClassSelector(runtimeClass); ok {
+			selectors = append(selectors, selector)
+		}
+	}
+
+	return selectors, nil
+}
+
+// handleRuntimeClassEvent drops the cached Kata RuntimeClass selectors and re-detects the nodes
+// selected by the Kata RuntimeClasses of an event, so that adding or removing a class flips their
+// kata labels without waiting for the next resync
+func (l *Labeler) handleRuntimeClassEvent(objs ...any) {
+	l.kataSelectors.invalidate()
+
+	var selectors []labels.Selector
+
+	for _, obj := range objs {
+		runtimeClass, ok := obj.(*nodev1.RuntimeClass)
+		if !ok {
+			slog.Error("Runtime class event: unexpected object", "type", fmt.Sprintf("%T", obj))
+			continue
+		}
+
+		if selector, ok := kataRuntimeClassSelector(runtimeClass); ok {
+			selectors = append(selectors, selector)
+		}
+	}
+
+	if len(selectors) == 0 {
+		return
+	}
+
+	for _, obj := range l.nodeInformer.GetStore().List() {
+		node, ok := obj.(*v1.Node)
+		if !ok {
+			continue
+		}
+
+		selected := slices.ContainsFunc(selectors, func(selector labels.Selector) bool {
+			return selector.Matches(labels.Set(node.Labels))
+		})
+		if !selected {
+			continue
+		}
+
+		if err := l.handleNodeEvent(node); err != nil {
+			slog.Error("Failed to re-detect kata after runtime class change", "node", node.Name, "error", err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	nodelisters "k8s.io/client-go/listers/node/v1"
+)
+
+func newRuntimeClass(name, handler string, nodeSelector map[string]string) *nodev1.RuntimeClass {
+	runtimeClass := &nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: name}, Handler: handler}
+	if nodeSelector != nil {
+		runtimeClass.Scheduling = &nodev1.Scheduling{NodeSelector: nodeSelector}
+	}
+
+	return runtimeClass
+}
+
+func TestKataRuntimeClassSelector(t *testing.T) {
+	sandboxNodes := map[string]string{"example.com/sandbox": "kata"}
+
+	tests := []struct {
+		name         string
+		runtimeClass *nodev1.RuntimeClass
+		selected     bool
+	}{
+		{
+			name:         "kata handler selecting nodes",
+			runtimeClass: newRuntimeClass("kata-qemu", "kata-qemu", sandboxNodes),
+			selected:     true,
+		},
+		{
+			name:         "kata handler without a node selector",
+			runtimeClass: newRuntimeClass("kata", "kata", nil),
+		},
+		{
+			name:         "other handler selecting nodes",
+			runtimeClass: newRuntimeClass("gvisor", "runsc", sandboxNodes),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, ok := kataRuntimeClassSelector(tt.runtimeClass)
+			assert.Equal(t, tt.selected, ok)
+
+			if tt.selected {
+				assert.Equal(t, "example.com/sandbox=kata", selector.String())
+			}
+		})
+	}
+}
+
+func TestRuntimeClassWatch_FlipsKataLabel(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "sandbox-node",
+		Labels: map[string]string{"example.com/sandbox": "kata"},
+	}}
+	other := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other-node"}}
+	clientset := fake.NewSimpleClientset(node, other)
+
+	// The resync period is far longer than the test, so only the RuntimeClass watch can flip the label
+	l, err := NewLabeler(clientset, time.Hour, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	require.NoError(t, l.SetKataRuntimeClassDetection(true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- l.Run(ctx) }()
+
+	assert.Eventually(t, func() bool {
+		return getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel] == LabelValueFalse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = clientset.NodeV1().RuntimeClasses().Create(ctx,
+		newRuntimeClass("kata-qemu", "kata-qemu", map[string]string{"example.com/sandbox": "kata"}),
+		metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel] == LabelValueTrue
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, LabelValueFalse, getTestNode(t, clientset, other.Name).Labels[KataEnabledLabel])
+
+	require.NoError(t, clientset.NodeV1().RuntimeClasses().Delete(ctx, "kata-qemu", metav1.DeleteOptions{}))
+
+	assert.Eventually(t, func() bool {
+		return getTestNode(t, clientset, node.Name).Labels[KataEnabledLabel] == LabelValueFalse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+// countingRuntimeClassLister counts the RuntimeClass lists served by the informer cache
+type countingRuntimeClassLister struct {
+	nodelisters.RuntimeClassLister
+
+	lists int
+}
+
+func (c *countingRuntimeClassLister) List(selector labels.Selector) ([]*nodev1.RuntimeClass, error) {
+	c.lists++
+
+	return c.RuntimeClassLister.List(selector)
+}
+
+func TestHasKataRuntimeClass_CachesSelectorsUntilRuntimeClassChanges(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "sandbox-node",
+		Labels: map[string]string{"example.com/sandbox": "kata"},
+	}}
+
+	l, err := NewLabeler(fake.NewSimpleClientset(node), time.Minute,
+		[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	require.NoError(t, l.SetKataRuntimeClassDetection(true))
+
+	lister := &countingRuntimeClassLister{RuntimeClassLister: l.runtimeClasses}
+	l.runtimeClasses = lister
+
+	// An unchanged RuntimeClass set is served from the cache
+	assert.False(t, l.hasKataRuntimeClass(node))
+	assert.False(t, l.hasKataRuntimeClass(node))
+	assert.Equal(t, 1, lister.lists)
+
+	// A RuntimeClass change delivered by the informer triggers a new list
+	runtimeClass := newRuntimeClass("kata-qemu", "kata-qemu", map[string]string{"example.com/sandbox": "kata"})
+	require.NoError(t, l.runtimeClassInformer.GetIndexer().Add(runtimeClass))
+	l.handleRuntimeClassEvent(runtimeClass)
+
+	assert.True(t, l.hasKataRuntimeClass(node))
+	assert.True(t, l.hasKataRuntimeClass(node))
+	assert.Equal(t, 2, lister.lists)
+
+	require.NoError(t, l.runtimeClassInformer.GetIndexer().Delete(runtimeClass))
+	l.handleRuntimeClassEvent(runtimeClass)
+
+	assert.False(t, l.hasKataRuntimeClass(node))
+	assert.Equal(t, 3, lister.lists)
+}
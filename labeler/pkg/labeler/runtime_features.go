@@ -0,0 +1,162 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/stringutil"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// RuntimeFeatureLabelFormat is the node label a runtime feature is written to, by feature name
+	RuntimeFeatureLabelFormat = "nvsentinel.dgxc.nvidia.com/%s.enabled"
+
+	// kataFeatureName is reserved, since the kata label is written from the Kata detection
+	kataFeatureName = "kata"
+
+	runtimeFeatureLabelPrefix      = "label:"
+	runtimeFeatureAnnotationPrefix = "annotation:"
+)
+
+// RuntimeFeature detects a node runtime feature such as confidential-compute or gvisor. A node has
+// the feature if any of the labels or annotations has a truthy value ("true", "enabled", "1" or
+// "yes", case-insensitive).
+type RuntimeFeature struct {
+	Name        string
+	Labels      []string
+	Annotations []string
+}
+
+// RuntimeFeatureLabel returns the node label the named runtime feature is written to
+func RuntimeFeatureLabel(name string) string {
+	return fmt.Sprintf(RuntimeFeatureLabelFormat, name)
+}
+
+// ParseRuntimeFeature parses a runtime feature from "name=key,..." where each key is a node label,
+// optionally prefixed with "label:", or a node annotation prefixed with "annotation:", e.g.
+// "confidential-compute=amd.com/sev,annotation:example.com/tdx"
+func ParseRuntimeFeature(spec string) (RuntimeFeature, error) {
+	name, keys, found := strings.Cut(spec, "=")
+	if !found {
+		return RuntimeFeature{}, fmt.Errorf("invalid runtime feature %q: expected name=key,...", spec)
+	}
+
+	feature := RuntimeFeature{Name: strings.TrimSpace(name)}
+
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+
+		switch {
+		case key == "":
+			continue
+		case strings.HasPrefix(key, runtimeFeatureAnnotationPrefix):
+			feature.Annotations = append(feature.Annotations, strings.TrimPrefix(key, runtimeFeatureAnnotationPrefix))
+		default:
+			feature.Labels = append(feature.Labels, strings.TrimPrefix(key, runtimeFeatureLabelPrefix))
+		}
+	}
+
+	return feature, nil
+}
+
+// validate checks that the feature name produces a valid node label and that the keys are valid
+func (f RuntimeFeature) validate() error {
+	if f.Name == kataFeatureName {
+		return fmt.Errorf("runtime feature name %q is reserved for the kata detection", kataFeatureName)
+	}
+
+	if errs := validation.IsDNS1123Label(f.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid runtime feature name %q: %s", f.Name, strings.Join(errs, "; "))
+	}
+
+	if errs := validation.IsQualifiedName(RuntimeFeatureLabel(f.Name)); len(errs) > 0 {
+		return fmt.Errorf("invalid runtime feature name %q: %s", f.Name, strings.Join(errs, "; "))
+	}
+
+	if len(f.Labels) == 0 && len(f.Annotations) == 0 {
+		return fmt.Errorf("runtime feature %q has no labels or annotations to detect it from", f.Name)
+	}
+
+	for _, key := range append(append([]string{}, f.Labels...), f.Annotations...) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid key %q of runtime feature %q: %s", key, f.Name, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+// SetRuntimeFeatures configures the runtime features detected alongside Kata. Each feature is
+// written to its own RuntimeFeatureLabel as "true" or "false". Feature names must be unique DNS
+// labels other than "kata".
+func (l *Labeler) SetRuntimeFeatures(features []RuntimeFeature) error {
+	seen := make(map[string]bool, len(features))
+
+	for _, feature := range features {
+		if err := feature.validate(); err != nil {
+			return err
+		}
+
+		if seen[feature.Name] {
+			return fmt.Errorf("duplicate runtime feature %q", feature.Name)
+		}
+
+		seen[feature.Name] = true
+	}
+
+	l.runtimeFeatures = features
+
+	return nil
+}
+
+// detectRuntimeFeatures returns whether the node has each configured runtime feature, by name, or
+// nil if none is configured
+func detectRuntimeFeatures(node *v1.Node, features []RuntimeFeature) map[string]bool {
+	if len(features) == 0 {
+		return nil
+	}
+
+	detected := make(map[string]bool, len(features))
+
+	for _, feature := range features {
+		detected[feature.Name] = hasTruthyKey(node, "label", node.Labels, feature.Labels) ||
+			hasTruthyKey(node, "annotation", node.Annotations, feature.Annotations)
+	}
+
+	return detected
+}
+
+// hasTruthyKey returns true if any of the keys has a truthy value in the node labels or annotations
+func hasTruthyKey(node *v1.Node, source string, values map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if value, exists := values[key]; exists && stringutil.IsTruthyValue(value) {
+			slog.Debug("Node runtime feature key matched",
+				"source", source,
+				"node", node.Name,
+				"key", key,
+				"value", value,
+			)
+
+			return true
+		}
+	}
+
+	return false
+}
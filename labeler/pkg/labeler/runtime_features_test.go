@@ -0,0 +1,179 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseRuntimeFeature(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		expected RuntimeFeature
+		wantErr  bool
+	}{
+		{
+			name:     "bare label keys",
+			spec:     "gvisor=runtime.gvisor.dev/enabled",
+			expected: RuntimeFeature{Name: "gvisor", Labels: []string{"runtime.gvisor.dev/enabled"}},
+		},
+		{
+			name: "labels and annotations",
+			spec: "confidential-compute=label:amd.com/sev, annotation:example.com/tdx,intel.com/tdx",
+			expected: RuntimeFeature{
+				Name:        "confidential-compute",
+				Labels:      []string{"amd.com/sev", "intel.com/tdx"},
+				Annotations: []string{"example.com/tdx"},
+			},
+		},
+		{
+			name:    "missing keys separator",
+			spec:    "gvisor",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feature, err := ParseRuntimeFeature(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, feature)
+		})
+	}
+}
+
+func TestSetRuntimeFeatures_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		features []RuntimeFeature
+		wantErr  bool
+	}{
+		{
+			name: "valid features",
+			features: []RuntimeFeature{
+				{Name: "confidential-compute", Labels: []string{"amd.com/sev"}},
+				{Name: "gvisor", Annotations: []string{"runtime.gvisor.dev/enabled"}},
+			},
+		},
+		{
+			name:     "reserved kata name",
+			features: []RuntimeFeature{{Name: "kata", Labels: []string{"example.com/kata"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid name",
+			features: []RuntimeFeature{{Name: "Confidential_Compute", Labels: []string{"amd.com/sev"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "no keys",
+			features: []RuntimeFeature{{Name: "gvisor"}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid key",
+			features: []RuntimeFeature{{Name: "gvisor", Labels: []string{"not a label"}}},
+			wantErr:  true,
+		},
+		{
+			name: "duplicate name",
+			features: []RuntimeFeature{
+				{Name: "gvisor", Labels: []string{"runtime.gvisor.dev/enabled"}},
+				{Name: "gvisor", Labels: []string{"example.com/gvisor"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLabeler(fake.NewSimpleClientset(), time.Minute,
+				[]string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+			require.NoError(t, err)
+
+			err = l.SetRuntimeFeatures(tt.features)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, l.runtimeFeatures)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRuntimeFeatures_DetectsMultipleFeatures(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name: "test-node",
+		Labels: map[string]string{
+			KataRuntimeDefaultLabel: "true",
+			"amd.com/sev":           "enabled",
+		},
+		Annotations: map[string]string{
+			"runtime.gvisor.dev/enabled": "yes",
+		},
+	}}
+	clientset := fake.NewSimpleClientset(node)
+	ctx := context.Background()
+
+	l, err := NewLabeler(clientset, time.Minute, []string{"nvidia-dcgm"}, []string{"nvidia-driver-daemonset"}, "")
+	require.NoError(t, err)
+	require.NoError(t, l.SetRuntimeFeatures([]RuntimeFeature{
+		{Name: "confidential-compute", Labels: []string{"intel.com/tdx", "amd.com/sev"}},
+		{Name: "gvisor", Annotations: []string{"runtime.gvisor.dev/enabled"}},
+		{Name: "wasm", Labels: []string{"example.com/wasm"}},
+	}))
+
+	detection, err := l.detectKata(ctx, node)
+	require.NoError(t, err)
+	assert.True(t, detection.IsKata)
+	assert.Equal(t, map[string]bool{"confidential-compute": true, "gvisor": true, "wasm": false}, detection.Features)
+
+	require.NoError(t, l.handleNodeEvent(node))
+
+	updated := getTestNode(t, clientset, node.Name)
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[RuntimeFeatureLabel("confidential-compute")])
+	assert.Equal(t, LabelValueTrue, updated.Labels[RuntimeFeatureLabel("gvisor")])
+	assert.Equal(t, LabelValueFalse, updated.Labels[RuntimeFeatureLabel("wasm")])
+
+	// Dropping a feature's annotation flips only its label
+	delete(updated.Annotations, "runtime.gvisor.dev/enabled")
+	updated, err = clientset.CoreV1().Nodes().Update(ctx, updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, l.handleNodeEvent(updated))
+
+	updated = getTestNode(t, clientset, node.Name)
+	assert.Equal(t, LabelValueTrue, updated.Labels[KataEnabledLabel])
+	assert.Equal(t, LabelValueTrue, updated.Labels[RuntimeFeatureLabel("confidential-compute")])
+	assert.Equal(t, LabelValueFalse, updated.Labels[RuntimeFeatureLabel("gvisor")])
+}
@@ -43,6 +43,56 @@ var (
 		},
 	)
 
+	// KataDetectionMethodWins tracks which detection method produced each positive Kata detection
+	KataDetectionMethodWins = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kata_detection_method_wins_total",
+			Help: "Total number of positive Kata detections by node and the detection method that produced them.",
+		},
+		[]string{"node", "method"},
+	)
+
+	// KataDetectionAttempts tracks the Kata detections run for nodes
+	KataDetectionAttempts = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "kata_detection_attempts_total",
+			Help: "Total number of Kata detections run for nodes.",
+		},
+	)
+
+	// KataDetectionFailures tracks the Kata detections whose result is unknown
+	KataDetectionFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "kata_detection_failures_total",
+			Help: "Total number of Kata detections that failed, leaving the result unknown.",
+		},
+	)
+
+	// KataDetectionDuration tracks the histogram of Kata detection durations
+	KataDetectionDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "kata_detection_duration_seconds",
+			Help:    "Histogram of Kata detection durations.",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
+	// KataCacheHits tracks custom resource Kata detections served from the per-node result cache
+	KataCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "nvsentinel_labeler_kata_cache_hits_total",
+			Help: "Total number of custom resource Kata detections served from the per-node cache.",
+		},
+	)
+
+	// KataCacheMisses tracks custom resource Kata detections that had to read the custom resource
+	KataCacheMisses = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "nvsentinel_labeler_kata_cache_misses_total",
+			Help: "Total number of custom resource Kata detections that read the custom resource.",
+		},
+	)
+
 	// EventHandlingDuration tracks the histogram of event handling durations
 	EventHandlingDuration = promauto.NewHistogram(
 		prometheus.HistogramOpts{